@@ -0,0 +1,158 @@
+package artifact_source
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// the maximum size of a digest file we will read
+const maxDigestFileSize = 1024 * 1024
+
+// IntegrityError is returned when a downloaded artifact does not match its expected size or checksum
+// this is retryable, as the most likely cause is a corrupted or truncated download
+type IntegrityError struct {
+	Name   string
+	Reason string
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("integrity verification failed for %s: %s", e.Name, e.Reason)
+}
+
+// DigestFileSource must be implemented by an ArtifactSource to support digest files (see types.DigestFile)
+type DigestFileSource interface {
+	// OpenDigestFile opens the digest file with the given name - the caller must close the reader
+	OpenDigestFile(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// verifyArtifact verifies the downloaded artifact against its expected size and checksum (if any),
+// populating the computed checksum in the DownloadedArtifactInfo
+func (a *ArtifactSourceImpl[S, T]) verifyArtifact(ctx context.Context, info *types.DownloadedArtifactInfo) error {
+	if !info.HasIntegrityInfo() {
+		return nil
+	}
+
+	if info.ExpectedSize != nil && *info.ExpectedSize != info.Size {
+		return &IntegrityError{Name: info.Name, Reason: fmt.Sprintf("expected size %d, got %d", *info.ExpectedSize, info.Size)}
+	}
+
+	expected := info.ExpectedChecksum
+	if expected == nil && info.DigestFile != nil {
+		var err error
+		expected, err = a.readDigestFile(ctx, info.Name, info.DigestFile)
+		if err != nil {
+			return err
+		}
+	}
+	if expected == nil {
+		return nil
+	}
+
+	actual, err := computeChecksum(info.LocalName, expected.Algorithm)
+	if err != nil {
+		return fmt.Errorf("error computing %s checksum of %s: %w", string(expected.Algorithm), info.Name, err)
+	}
+	if info.Checksums == nil {
+		info.Checksums = make(map[types.ChecksumAlgorithm]string)
+	}
+	info.Checksums[expected.Algorithm] = actual
+
+	if actual != expected.Value {
+		return &IntegrityError{Name: info.Name, Reason: fmt.Sprintf("expected %s checksum %s, got %s", string(expected.Algorithm), expected.Value, actual)}
+	}
+	slog.Debug("Artifact integrity verified", "artifact", info.Name, "checksum", expected.String())
+	return nil
+}
+
+// readDigestFile reads the expected checksum of the artifact from the digest file, using the source
+func (a *ArtifactSourceImpl[S, T]) readDigestFile(ctx context.Context, artifactName string, digestFile *types.DigestFile) (*types.Checksum, error) {
+	digestSource, ok := a.Source.(DigestFileSource)
+	if !ok {
+		return nil, fmt.Errorf("source %s does not support digest files", a.Source.Identifier())
+	}
+	r, err := digestSource.OpenDigestFile(ctx, digestFile.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error opening digest file %s: %w", digestFile.Name, err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxDigestFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading digest file %s: %w", digestFile.Name, err)
+	}
+	return parseDigestFile(data, artifactName, digestFile.Algorithm)
+}
+
+// parseDigestFile parses the checksum of the given artifact from the contents of a digest file
+// the following formats are supported:
+// - a bare checksum
+// - the output of sha256sum/md5sum etc. ('<checksum>  <file name>', one line per file)
+// - BSD style output ('SHA256 (<file name>) = <checksum>')
+// if the file contains multiple checksums, the one with a file name matching the artifact is used
+func parseDigestFile(data []byte, artifactName string, algorithm types.ChecksumAlgorithm) (*types.Checksum, error) {
+	artifactBase := path.Base(artifactName)
+
+	var candidates []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// BSD style
+		if open := strings.Index(line, " ("); open >= 0 {
+			if closeIdx := strings.LastIndex(line, ") = "); closeIdx > open {
+				if path.Base(line[open+2:closeIdx]) == artifactBase {
+					return types.NewChecksum(algorithm, line[closeIdx+4:])
+				}
+				continue
+			}
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 1 {
+			candidates = append(candidates, fields[0])
+			continue
+		}
+		// the file name may be prefixed with '*' to indicate binary mode
+		if path.Base(strings.TrimPrefix(fields[1], "*")) == artifactBase {
+			return types.NewChecksum(algorithm, fields[0])
+		}
+		candidates = append(candidates, fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// if there is no matching file name, there must be a single checksum
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("digest file does not contain a checksum for %s", artifactBase)
+	}
+	return types.NewChecksum(algorithm, candidates[0])
+}
+
+// computeChecksum computes the (hex encoded) checksum of the given file
+func computeChecksum(filePath string, algorithm types.ChecksumAlgorithm) (string, error) {
+	h, err := algorithm.NewHash()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,156 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// sha256 of "hello world"
+const testHelloSha256 = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+func Test_parseDigestFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "bare checksum",
+			data: testHelloSha256 + "\n",
+			want: testHelloSha256,
+		},
+		{
+			name: "sha256sum output",
+			data: strings.Repeat("0", 64) + "  other.log.gz\n" + testHelloSha256 + " *logs/access.log.gz\n",
+			want: testHelloSha256,
+		},
+		{
+			name: "BSD style",
+			data: "SHA256 (other.log.gz) = " + strings.Repeat("0", 64) + "\nSHA256 (access.log.gz) = " + testHelloSha256 + "\n",
+			want: testHelloSha256,
+		},
+		{
+			name: "single entry for another file",
+			data: testHelloSha256 + "  other.log.gz\n",
+			want: testHelloSha256,
+		},
+		{
+			name:    "no matching entry",
+			data:    testHelloSha256 + "  a.log.gz\n" + testHelloSha256 + "  b.log.gz\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid checksum",
+			data:    "not-a-checksum\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDigestFile([]byte(tt.data), "/data/access.log.gz", types.ChecksumAlgorithmSHA256)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got.Value)
+			}
+		})
+	}
+}
+
+// digestTestSource serves digest files from memory
+type digestTestSource struct {
+	*retryTestSource
+	digests map[string]string
+}
+
+func (s *digestTestSource) OpenDigestFile(_ context.Context, name string) (io.ReadCloser, error) {
+	d, ok := s.digests[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(strings.NewReader(d)), nil
+}
+
+func TestArtifactSourceImpl_verifyArtifact(t *testing.T) {
+	localPath := filepath.Join(t.TempDir(), "access.log")
+	writeTestFile(t, localPath, "hello world")
+	// base64 encoded crc32c of "hello world"
+	crc32c, _ := types.NewChecksum(types.ChecksumAlgorithmCRC32C, "yZRlqg==")
+
+	tests := []struct {
+		name          string
+		info          types.ArtifactInfo
+		wantErr       bool
+		wantIntegrity bool
+		wantChecksums map[types.ChecksumAlgorithm]string
+	}{
+		{
+			name: "no integrity info",
+		},
+		{
+			name: "size match",
+			info: types.ArtifactInfo{ExpectedSize: utils.ToPointer(int64(11))},
+		},
+		{
+			name:          "size mismatch",
+			info:          types.ArtifactInfo{ExpectedSize: utils.ToPointer(int64(12))},
+			wantErr:       true,
+			wantIntegrity: true,
+		},
+		{
+			name:          "checksum match",
+			info:          types.ArtifactInfo{ExpectedChecksum: crc32c},
+			wantChecksums: map[types.ChecksumAlgorithm]string{types.ChecksumAlgorithmCRC32C: "c99465aa"},
+		},
+		{
+			name:          "checksum mismatch",
+			info:          types.ArtifactInfo{ExpectedChecksum: &types.Checksum{Algorithm: types.ChecksumAlgorithmSHA256, Value: strings.Repeat("0", 64)}},
+			wantErr:       true,
+			wantIntegrity: true,
+			wantChecksums: map[types.ChecksumAlgorithm]string{types.ChecksumAlgorithmSHA256: testHelloSha256},
+		},
+		{
+			name:          "digest file",
+			info:          types.ArtifactInfo{DigestFile: &types.DigestFile{Algorithm: types.ChecksumAlgorithmSHA256, Name: "access.log.sha256"}},
+			wantChecksums: map[types.ChecksumAlgorithm]string{types.ChecksumAlgorithmSHA256: testHelloSha256},
+		},
+		{
+			name:    "missing digest file",
+			info:    types.ArtifactInfo{DigestFile: &types.DigestFile{Algorithm: types.ChecksumAlgorithmSHA256, Name: "missing.sha256"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &digestTestSource{
+				retryTestSource: newRetryTestSource(t),
+				digests:         map[string]string{"access.log.sha256": testHelloSha256 + "  access.log\n"},
+			}
+			s.Source = s
+
+			tt.info.Name = "access.log"
+			info := types.NewDownloadedArtifactInfo(&tt.info, localPath, 11)
+			err := s.verifyArtifact(context.Background(), info)
+			if tt.wantErr {
+				assert.Error(t, err)
+				var integrityErr *IntegrityError
+				assert.Equal(t, tt.wantIntegrity, errors.As(err, &integrityErr))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantChecksums, info.Checksums)
+		})
+	}
+}
@@ -1,13 +1,122 @@
 package artifact_source
 
 import (
+	"fmt"
 	"github.com/elastic/go-grok"
 	"github.com/turbot/pipe-fittings/v2/filter"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 )
 
+// defaultFileLayout is the layout used if no file_layout is configured - it matches every file
+const defaultFileLayout = "%{GREEDYDATA}"
+
+// newLayoutMatcher returns the layouts to match artifact paths against (expanding any optional segments of the
+// file layouts) and a grok parser containing the given patterns
+func newLayoutMatcher(fileLayouts []string, patterns map[string]string) ([]string, *grok.Grok, error) {
+	// expand any optional segments of the layouts
+	layouts, _ := expandFileLayouts(fileLayouts)
+
+	// create a grok parser, adding any patterns defined in config
+	g, err := grok.NewWithPatterns(patterns)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error adding grok patterns: %w", err)
+	}
+	return layouts, g, nil
+}
+
+// expandFileLayouts returns the layouts to match artifact paths against, in order - the alternatives of each of the
+// file layouts (see ExpandPatternIntoOptionalAlternatives) - along with a map of each of these to the file layout
+// it was expanded from
+// if there are no file layouts, the default layout is used
+func expandFileLayouts(fileLayouts []string) ([]string, map[string]string) {
+	if len(fileLayouts) == 0 {
+		fileLayouts = []string{defaultFileLayout}
+	}
+	var layouts []string
+	sources := make(map[string]string)
+	for _, fileLayout := range fileLayouts {
+		for _, layout := range ExpandPatternIntoOptionalAlternatives(fileLayout) {
+			// if the same layout is expanded from more than one file layout, the first is used
+			if _, ok := sources[layout]; ok {
+				continue
+			}
+			layouts = append(layouts, layout)
+			sources[layout] = fileLayout
+		}
+	}
+	return layouts, sources
+}
+
+// layoutCompiler returns a grok parser compiled for the given layout
+type layoutCompiler func(layout string) (*grok.Grok, error)
+
+// compileWith returns a layoutCompiler which compiles each layout using the given parser
+// NOTE: the parser is recompiled for every layout, so this must not be used concurrently
+func compileWith(g *grok.Grok) layoutCompiler {
+	return func(layout string) (*grok.Grok, error) {
+		return g, g.Compile(layout, true)
+	}
+}
+
+// layoutCache holds grok parsers compiled for each of the (expanded) file layouts, and for each directory prefix of
+// those layouts, so the patterns are compiled once per source rather than for every path visited
+// each parser is only used for matching once compiled, so the cache may be used concurrently
+type layoutCache struct {
+	patterns map[string]string
+
+	mut     sync.RWMutex
+	parsers map[string]*grok.Grok
+}
+
+// newLayoutCache creates a layoutCache, compiling the expanded file layouts and their directory prefixes
+func newLayoutCache(fileLayouts []string, patterns map[string]string) (*layoutCache, error) {
+	c := &layoutCache{
+		patterns: patterns,
+		parsers:  make(map[string]*grok.Grok),
+	}
+	layouts, _ := expandFileLayouts(fileLayouts)
+	for _, l := range layouts {
+		if _, err := c.compile(l); err != nil {
+			return nil, err
+		}
+		// compile the prefixes used to match directories at each depth (see getPathSegmentMetadata)
+		layoutParts := strings.Split(l, "/")
+		for depth := 1; depth <= len(layoutParts); depth++ {
+			if _, err := c.compile(dirLayout(strings.Join(layoutParts[:depth], "/"))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// compile returns the parser for the layout, compiling it if it is not already cached
+// implements layoutCompiler
+func (c *layoutCache) compile(layout string) (*grok.Grok, error) {
+	c.mut.RLock()
+	g, ok := c.parsers[layout]
+	c.mut.RUnlock()
+	if ok {
+		return g, nil
+	}
+
+	g, err := grok.NewWithPatterns(c.patterns)
+	if err != nil {
+		return nil, fmt.Errorf("error adding grok patterns: %w", err)
+	}
+	if err := g.Compile(layout, true); err != nil {
+		return nil, err
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.parsers[layout] = g
+	return g, nil
+}
+
 func ByteMapToStringMap(m map[string][]byte) map[string]string {
 	res := make(map[string]string, len(m))
 	for k, v := range m {
@@ -77,7 +186,7 @@ func metadataSatisfiesFilters(metadata map[string]string, filters map[string]*fi
 
 // getPathMetadata get the metadata from the given file path, based on the file layout
 // returns whether the path matches the layout pattern, and the medata map
-func getPathMetadata(targetPath, basePath string, layout string, isDir bool, g *grok.Grok) (bool, map[string]string, error) {
+func getPathMetadata(targetPath, basePath string, layout string, isDir bool, compile layoutCompiler) (bool, map[string]string, error) {
 	// remove the base path from the path
 	relPath, err := filepath.Rel(basePath, targetPath)
 	if err != nil {
@@ -86,13 +195,13 @@ func getPathMetadata(targetPath, basePath string, layout string, isDir bool, g *
 
 	// if this is a directory, we just want to evaluate the pattern segments up to this directory
 	// so call getPathSegmentMetadata which trims the pattern to match the path length
-	var getMetadataFunc func(g *grok.Grok, pathSegment, fileLayout string) (bool, map[string][]byte, error)
+	var getMetadataFunc func(compile layoutCompiler, pathSegment, fileLayout string) (bool, map[string][]byte, error)
 	if isDir {
 		getMetadataFunc = getPathSegmentMetadata
 	} else {
 		getMetadataFunc = getPathLeafMetadata
 	}
-	match, metadata, err := getMetadataFunc(g, relPath, layout)
+	match, metadata, err := getMetadataFunc(compile, relPath, layout)
 	if err != nil {
 		return false, nil, err
 	}
@@ -105,7 +214,7 @@ func getPathMetadata(targetPath, basePath string, layout string, isDir bool, g *
 // based on the file layout, which is a grok pattern
 // the grok pattern is assumed to start at the beginning of the path segment
 // - it is trimmed to the length of the path segment
-func getPathSegmentMetadata(g *grok.Grok, pathSegment, fileLayout string) (bool, map[string][]byte, error) {
+func getPathSegmentMetadata(compile layoutCompiler, pathSegment, fileLayout string) (bool, map[string][]byte, error) {
 	// Split and truncate the file layout to match the path segment's length
 	pathParts := strings.Split(pathSegment, "/")
 	layoutParts := strings.Split(fileLayout, "/")
@@ -141,15 +250,21 @@ func getPathSegmentMetadata(g *grok.Grok, pathSegment, fileLayout string) (bool,
 	if !strings.HasSuffix(pathSegment, "/") {
 		pathSegment = pathSegment + "/"
 	}
-	if !strings.HasSuffix(fileLayout, "/") {
-		fileLayout = fileLayout + "/"
-	}
+	fileLayout = dirLayout(fileLayout)
 	// this covers the case where the pattern is "/foo/AWS" and the path is "/foo/AWSLogs" which should fail
 	// but will pass without the trailing slashes
 
 	// Extract metadata from the path segment
-	return getPathLeafMetadata(g, pathSegment, fileLayout)
+	return getPathLeafMetadata(compile, pathSegment, fileLayout)
+
+}
 
+// dirLayout returns the layout used to match a directory, i.e. with a trailing slash
+func dirLayout(layout string) string {
+	if !strings.HasSuffix(layout, "/") {
+		return layout + "/"
+	}
+	return layout
 }
 
 func isWildcard(s string) bool {
@@ -159,8 +274,8 @@ func isWildcard(s string) bool {
 // getPathLeafMetadata extracts metadata from a path
 // based on the file layout, which is a grok pattern
 // the grok pattern is assumed to start at the beginning of the path segment
-func getPathLeafMetadata(g *grok.Grok, filepath string, layout string) (bool, map[string][]byte, error) {
-	err := g.Compile(layout, true)
+func getPathLeafMetadata(compile layoutCompiler, filepath string, layout string) (bool, map[string][]byte, error) {
+	g, err := compile(layout)
 	if err != nil {
 		return false, nil, err
 	}
@@ -303,9 +303,9 @@ func Test_getPathMetadata(t *testing.T) {
 			var metadata map[string][]byte
 			var match bool
 			if tt.args.isFile {
-				match, metadata, err = getPathLeafMetadata(g, tt.args.pathSegment, tt.args.fileLayout)
+				match, metadata, err = getPathLeafMetadata(compileWith(g), tt.args.pathSegment, tt.args.fileLayout)
 			} else {
-				match, metadata, err = getPathSegmentMetadata(g, tt.args.pathSegment, tt.args.fileLayout)
+				match, metadata, err = getPathSegmentMetadata(compileWith(g), tt.args.pathSegment, tt.args.fileLayout)
 			}
 			if err != nil {
 				if !tt.wantErr {
@@ -317,6 +317,22 @@ func Test_getPathMetadata(t *testing.T) {
 				t.Fatalf("expected error, got none")
 			}
 
+			// the layouts compiled when the source is initialised must give the same result
+			cache, err := newLayoutCache([]string{tt.args.fileLayout}, patterns)
+			if err != nil {
+				t.Fatalf("failed to compile layout: %v", err)
+			}
+			var cachedMetadata map[string][]byte
+			var cachedMatch bool
+			if tt.args.isFile {
+				cachedMatch, cachedMetadata, err = getPathLeafMetadata(cache.compile, tt.args.pathSegment, tt.args.fileLayout)
+			} else {
+				cachedMatch, cachedMetadata, err = getPathSegmentMetadata(cache.compile, tt.args.pathSegment, tt.args.fileLayout)
+			}
+			if err != nil || cachedMatch != match || !reflect.DeepEqual(cachedMetadata, metadata) {
+				t.Errorf("cached layout: match = %v, metadata = %v, err = %v, want match = %v, metadata = %v", cachedMatch, cachedMetadata, err, match, metadata)
+			}
+
 			// if the pattern match fails but we wanted a match
 			if !match && tt.wantMatch {
 				t.Errorf("match = %v, wantMatch %v", match, tt.wantMatch)
@@ -395,3 +411,133 @@ func Test_expandPatternIntoOptionalAlternatives(t *testing.T) {
 		})
 	}
 }
+
+func Test_expandFileLayouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileLayouts []string
+		want        []string
+		wantSources map[string]string
+	}{
+		{
+			name: "no layouts",
+			want: []string{defaultFileLayout},
+			wantSources: map[string]string{
+				defaultFileLayout: defaultFileLayout,
+			},
+		},
+		{
+			name: "layouts in order, with optional segments expanded",
+			fileLayouts: []string{
+				"logs/%{YEAR:year}/(%{WORD:region}/)?%{DATA}.log",
+				"old_logs/%{DATA}.log",
+			},
+			want: []string{
+				"logs/%{YEAR:year}/%{WORD:region}/%{DATA}.log",
+				"logs/%{YEAR:year}/%{DATA}.log",
+				"old_logs/%{DATA}.log",
+			},
+			wantSources: map[string]string{
+				"logs/%{YEAR:year}/%{WORD:region}/%{DATA}.log": "logs/%{YEAR:year}/(%{WORD:region}/)?%{DATA}.log",
+				"logs/%{YEAR:year}/%{DATA}.log":                "logs/%{YEAR:year}/(%{WORD:region}/)?%{DATA}.log",
+				"old_logs/%{DATA}.log":                         "old_logs/%{DATA}.log",
+			},
+		},
+		{
+			name: "duplicate alternative uses the first layout",
+			fileLayouts: []string{
+				"logs/(%{WORD:region}/)?%{DATA}.log",
+				"logs/%{DATA}.log",
+			},
+			want: []string{
+				"logs/%{WORD:region}/%{DATA}.log",
+				"logs/%{DATA}.log",
+			},
+			wantSources: map[string]string{
+				"logs/%{WORD:region}/%{DATA}.log": "logs/(%{WORD:region}/)?%{DATA}.log",
+				"logs/%{DATA}.log":                "logs/(%{WORD:region}/)?%{DATA}.log",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sources := expandFileLayouts(tt.fileLayouts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandFileLayouts() layouts = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(sources, tt.wantSources) {
+				t.Errorf("expandFileLayouts() sources = %v, want %v", sources, tt.wantSources)
+			}
+		})
+	}
+}
+
+// BenchmarkGetPathMetadata compares compiling the layout for every path with using the layouts compiled
+// when the source is initialised
+func BenchmarkGetPathMetadata(b *testing.B) {
+	dir := strings.Join(org1_account1_regionuseast1_with_date[:6], "/")
+	file := strings.Join(org1_account1_regionuseast1_with_date, "/")
+
+	b.Run("compile per path", func(b *testing.B) {
+		g, err := grok.NewWithPatterns(patterns)
+		if err != nil {
+			b.Fatal(err)
+		}
+		compile := compileWith(g)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _, _ = getPathMetadata(dir, "", pattern, true, compile)
+			_, _, _ = getPathMetadata(file, "", pattern, false, compile)
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		cache, err := newLayoutCache([]string{pattern}, patterns)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _, _ = getPathMetadata(dir, "", pattern, true, cache.compile)
+			_, _, _ = getPathMetadata(file, "", pattern, false, cache.compile)
+		}
+	})
+}
+
+func Test_getMetadataAndApplyFilters(t *testing.T) {
+	config := &FileSystemSourceConfig{}
+	config.FileLayouts = []string{"logs/%{NOTSPACE:region}/%{YEAR:year}/%{NOTSPACE:file_name}.%{WORD:ext}"}
+	config.Filters = []string{"region in ('us-east-1', 'us-west-2')"}
+	config.Exclude = []string{"**/*.tmp", "logs/us-west-2/2023"}
+	if err := config.ArtifactSourceConfigImpl.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	a := &ArtifactSourceImpl[*FileSystemSourceConfig, *EmptyConnection]{}
+	a.Config = config
+	g := grok.New()
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{path: "logs/us-east-1", isDir: true, want: true},
+		{path: "logs/eu-west-1", isDir: true, want: false},
+		{path: "logs/us-east-1/2024/a.log", want: true},
+		{path: "logs/eu-west-1/2024/a.log", want: false},
+		{path: "logs/us-east-1/2024/a.tmp", want: false},
+		{path: "logs/us-west-2/2023", isDir: true, want: false},
+		{path: "logs/us-west-2/2024", isDir: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, satisfied, err := a.getMetadataAndApplyFilters("/base/"+tt.path, "/base", config.FileLayouts, tt.isDir, g, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if satisfied != tt.want {
+				t.Errorf("getMetadataAndApplyFilters() satisfied = %v, want %v", satisfied, tt.want)
+			}
+		})
+	}
+}
@@ -4,18 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
+	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/dustin/go-humanize"
 	"github.com/elastic/go-grok"
 	"github.com/turbot/pipe-fittings/v2/filter"
 	"github.com/turbot/pipe-fittings/v2/utils"
 	"github.com/turbot/tailpipe-plugin-sdk/artifact_loader"
 	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
 	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
 	"github.com/turbot/tailpipe-plugin-sdk/context_values"
 	"github.com/turbot/tailpipe-plugin-sdk/events"
 	"github.com/turbot/tailpipe-plugin-sdk/helpers"
@@ -26,8 +32,15 @@ import (
 	"github.com/turbot/tailpipe-plugin-sdk/types"
 )
 
+// ArtifactSourceMaxConcurrency is the default maximum number of concurrent downloads
+// (this may be overridden using the limiter config)
 const ArtifactSourceMaxConcurrency = 16
 
+const (
+	artifactDownloadLimiterName  = "artifact_load_limiter"
+	artifactBandwidthLimiterName = "artifact_bandwidth_limiter"
+)
+
 // ArtifactSourceImpl is a [row_source.RowSource] that extracts rows from an 'artifact'
 //
 // Artifacts are defined as some entity which contains a collection of rows, which must be extracted/processed in
@@ -59,6 +72,10 @@ type ArtifactSourceImpl[S artifact_source_config.ArtifactSourceConfig, T parse.C
 	// to be a subdirectory of the collection directory
 	TempDir string
 
+	// if set, the source is in watch mode - after the initial discovery it continues to collect new artifacts
+	// until the collection is cancelled. This is set by sources which support watching (see WatchPaths)
+	WatchConfig *artifact_source_config.WatchConfig
+
 	// shadow the row_source.RowSourceImpl Source property, but using ArtifactSource interface
 	Source ArtifactSource
 
@@ -74,8 +91,24 @@ type ArtifactSourceImpl[S artifact_source_config.ArtifactSourceConfig, T parse.C
 	loaders    map[string]artifact_loader.Loader
 	loaderLock sync.RWMutex
 
+	// the policy used to retry failed downloads - this may be set using the WithRetryPolicy option
+	RetryPolicy *RetryPolicy
+
 	// rate limiters
 	artifactDownloadLimiter *rate_limiter.APILimiter
+	// if set, limits the aggregate throughput of all downloads (in bytes per second)
+	artifactBandwidthLimiter *rate_limiter.APILimiter
+	// tracks the disk space used by downloaded artifacts in the temp directory
+	tempDirBudget *tempDirBudget
+	// grok parsers for the file layout, compiled in Init
+	layoutCache *layoutCache
+	// map of each expanded file layout to the configured file layout it was expanded from, populated in Init
+	fileLayoutSources map[string]string
+	// the granularity of each configured file layout, populated in Init
+	fileLayoutGranularities map[string]time.Duration
+
+	// map of artifact name to the state of the in progress download of that artifact
+	activeDownloads sync.Map
 
 	// wait group to wait for all artifacts to be extracted
 	// this is incremented each time we discover an artifact and decremented when we have extracted it
@@ -106,6 +139,16 @@ func (a *ArtifactSourceImpl[S, T]) Init(ctx context.Context, params *row_source.
 	// apply default artifact config (this handles null default)
 	a.Config.DefaultTo(a.defaultConfig)
 
+	// now the default file layout has been applied, check the filters refer to fields of the layout
+	if err := artifact_source_config.ValidateFilterFields(a.Config.GetFilterMap(), a.Config.GetFileLayouts()); err != nil {
+		return fmt.Errorf("invalid source config: %w", err)
+	}
+
+	// only the lines appended to uncompressed, line based artifacts can be collected
+	if err := a.validateChangePolicy(); err != nil {
+		return fmt.Errorf("invalid source config: %w", err)
+	}
+
 	// store RowSourceImpl.Source as an ArtifactSource (shadow the base Source property)
 	impl, ok := a.RowSourceImpl.Source.(ArtifactSource)
 	if !ok {
@@ -120,14 +163,42 @@ func (a *ArtifactSourceImpl[S, T]) Init(ctx context.Context, params *row_source.
 	}
 	a.CollectionState = cs
 
-	// set the granularity
-	a.CollectionState.SetGranularity(helpers.GetGranularityFromFileLayout(a.Config.GetFileLayout()))
+	// compile the file layout patterns once, for use when walking paths
+	layoutCache, err := newLayoutCache(a.Config.GetFileLayouts(), a.Config.GetPatterns())
+	if err != nil {
+		return fmt.Errorf("error compiling file layout: %w", err)
+	}
+	a.layoutCache = layoutCache
+
+	// determine the granularity of each file layout and set the granularity of the collection state
+	if err := a.initLayoutGranularity(); err != nil {
+		return err
+	}
+
+	if a.RetryPolicy == nil {
+		a.RetryPolicy = DefaultRetryPolicy()
+	}
 
-	// setup rate limiter
-	a.artifactDownloadLimiter = rate_limiter.NewAPILimiter(&rate_limiter.Definition{
-		Name:           "artifact_load_limiter",
+	// setup rate limiter - this may be configured using the limiter block
+	limiterDefinition := &rate_limiter.Definition{
+		Name:           artifactDownloadLimiterName,
 		MaxConcurrency: ArtifactSourceMaxConcurrency,
-	})
+	}
+	if limiterConfig := a.Config.GetLimiterConfig(); limiterConfig != nil {
+		limiterDefinition = limiterConfig.GetDefinition(artifactDownloadLimiterName, ArtifactSourceMaxConcurrency)
+	}
+	if validationErrors := limiterDefinition.Validate(); len(validationErrors) > 0 {
+		return fmt.Errorf("invalid download limiter: %s", strings.Join(validationErrors, ", "))
+	}
+	a.artifactDownloadLimiter = rate_limiter.NewAPILimiter(limiterDefinition)
+	slog.Info("Artifact download limits", "source", a.Source.Identifier(), "limits", limiterDefinition.String())
+	if limiterConfig := a.Config.GetLimiterConfig(); limiterConfig != nil {
+		if bandwidthDefinition := limiterConfig.GetBandwidthDefinition(artifactBandwidthLimiterName); bandwidthDefinition != nil {
+			a.artifactBandwidthLimiter = rate_limiter.NewAPILimiter(bandwidthDefinition)
+			slog.Info("Artifact download bandwidth limit", "source", a.Source.Identifier(), "bytes/s", bandwidthDefinition.BucketSize)
+		}
+	}
+	a.tempDirBudget = newTempDirBudget(a.Config.GetTempDirMaxSize())
 
 	return nil
 }
@@ -143,6 +214,72 @@ func (a *ArtifactSourceImpl[S, T]) SetExtractor(extractor Extractor) {
 	a.extractor = extractor
 }
 
+// validateChangePolicy checks that, if the change policy is append, the artifacts are uncompressed and
+// loaded a line at a time - appended data cannot be split from the data already collected for other formats
+func (a *ArtifactSourceImpl[S, T]) validateChangePolicy() error {
+	policy := a.Config.GetChangePolicy()
+	if policy != artifact_source_config.ChangePolicyAppend {
+		return nil
+	}
+	if err := artifact_source_config.ValidateChangePolicyFileLayouts(policy, a.Config.GetFileLayouts()); err != nil {
+		return err
+	}
+	// if a null loader is set, the artifacts are loaded elsewhere (e.g. by the plugin using this plugin as a source)
+	if a.hasNullLoader() {
+		return nil
+	}
+	if a.Loader != nil {
+		if _, ok := a.Loader.(*artifact_loader.FileRowLoader); !ok {
+			return fmt.Errorf("on_change = %s cannot be used with the %s loader", policy, a.Loader.Identifier())
+		}
+		return nil
+	}
+	if !a.RowPerLine {
+		return fmt.Errorf("on_change = %s can only be used with artifacts which contain a row per line", policy)
+	}
+	return nil
+}
+
+// initLayoutGranularity determines the granularity of each of the file layouts - this is used to parse the timestamp
+// of artifacts which match the layout
+// the collection state uses the coarsest granularity, so its end time covers the period of any artifact
+func (a *ArtifactSourceImpl[S, T]) initLayoutGranularity() error {
+	fileLayouts := a.Config.GetFileLayouts()
+	_, a.fileLayoutSources = expandFileLayouts(fileLayouts)
+
+	a.fileLayoutGranularities = make(map[string]time.Duration, len(fileLayouts))
+	var granularity time.Duration
+	var hasTimeLayout, hasNonTimeLayout bool
+	for _, fileLayout := range fileLayouts {
+		layoutGranularity := helpers.GetGranularityFromFileLayout(&fileLayout)
+		if layoutGranularity == 0 {
+			hasNonTimeLayout = true
+		} else {
+			hasTimeLayout = true
+			// as with the collection state, ensure the granularity is no smaller than the minimum
+			layoutGranularity = max(layoutGranularity, collection_state.MinArtifactGranularity)
+		}
+		a.fileLayoutGranularities[fileLayout] = layoutGranularity
+		granularity = max(granularity, layoutGranularity)
+	}
+	// the collection state can only track artifacts with timestamps, or without - not both
+	if hasTimeLayout && hasNonTimeLayout {
+		return fmt.Errorf("invalid file_layout: either all file layouts must contain a year, or none")
+	}
+
+	a.CollectionState.SetGranularity(granularity)
+	return nil
+}
+
+// artifactGranularity returns the granularity of the file layout which the artifact path matched
+// (falling back to the collection state granularity if this is not known)
+func (a *ArtifactSourceImpl[S, T]) artifactGranularity(metadata map[string]string) time.Duration {
+	if granularity, ok := a.fileLayoutGranularities[metadata[constants.ArtifactMetadataFileLayout]]; ok {
+		return granularity
+	}
+	return a.CollectionState.GetGranularity()
+}
+
 // SetDefaultConfig sets the default config for the source
 func (a *ArtifactSourceImpl[S, T]) SetDefaultConfig(config *artifact_source_config.ArtifactSourceConfigImpl) {
 	a.defaultConfig = config
@@ -156,12 +293,22 @@ func (a *ArtifactSourceImpl[S, T]) SetSkipHeaderRow(skipHeaderRow bool) {
 	a.SkipHeaderRow = skipHeaderRow
 }
 
+// SetRetryPolicy sets the policy used to retry failed downloads
+func (a *ArtifactSourceImpl[S, T]) SetRetryPolicy(policy *RetryPolicy) {
+	a.RetryPolicy = policy
+}
+
 // Collect tells our ArtifactSourceImpl to start discovering artifacts
 // Implements [plugin.RowSource]
 func (a *ArtifactSourceImpl[S, T]) Collect(ctx context.Context) error {
 	slog.Info("ArtifactSourceImpl Collect")
 	defer slog.Info("ArtifactSourceImpl Collect complete")
 
+	// report the initial download limits
+	if executionId, err := context_values.ExecutionIdFromContext(ctx); err == nil {
+		a.notifySourceStatus(ctx, executionId)
+	}
+
 	// tell out source to discover artifacts
 	// it will notify us of each artifact discovered
 	err := a.Source.DiscoverArtifacts(ctx)
@@ -175,6 +322,15 @@ func (a *ArtifactSourceImpl[S, T]) Collect(ctx context.Context) error {
 	return nil
 }
 
+// GetFlushInterval implements row_source.ContinuousRowSource
+// in watch mode, the collector flushes rows and saves the collection state at the configured interval
+func (a *ArtifactSourceImpl[S, T]) GetFlushInterval() time.Duration {
+	if a.WatchConfig == nil {
+		return 0
+	}
+	return a.WatchConfig.GetFlushInterval()
+}
+
 func (a *ArtifactSourceImpl[S, T]) OnArtifactDiscovered(ctx context.Context, info *types.ArtifactInfo) error {
 	executionId, err := context_values.ExecutionIdFromContext(ctx)
 	if err != nil {
@@ -186,12 +342,19 @@ func (a *ArtifactSourceImpl[S, T]) OnArtifactDiscovered(ctx context.Context, inf
 	// increment the extract wait group - this will be decremented when the artifact is extracted (or there is an error)
 	a.artifactExtractWg.Add(1)
 
+	// if the temp directory is over budget, wait for downloaded artifacts to be extracted and removed
+	if err := a.tempDirBudget.wait(ctx); err != nil {
+		a.artifactExtractWg.Done()
+		return fmt.Errorf("error waiting for temp directory space: %w", err)
+	}
+
 	t := time.Now()
 
 	// rate limit the download
 	slog.Debug("ArtifactDiscovered - rate limiter waiting", "artifact", info.Name)
 	err = a.artifactDownloadLimiter.Wait(ctx)
 	if err != nil {
+		a.artifactExtractWg.Done()
 		return fmt.Errorf("error acquiring rate limiter: %w", err)
 	}
 	slog.Debug("ArtifactDiscovered - rate limiter acquired", "duration", time.Since(t), "artifact", info.Name)
@@ -201,10 +364,18 @@ func (a *ArtifactSourceImpl[S, T]) OnArtifactDiscovered(ctx context.Context, inf
 			a.artifactDownloadLimiter.Release()
 			slog.Debug("ArtifactDiscovered - rate limiter released", "artifact", info.Name)
 		}()
-		// cast the source to an ArtifactSource and download the artifact
-		err = a.Source.DownloadArtifact(ctx, info)
+		// download the artifact, retrying if necessary
+		err := a.downloadArtifact(ctx, executionId, info)
 		if err != nil {
 			slog.Error("Error downloading artifact", "artifact", info.Name, "error", err)
+			// report integrity failures as artifact errors, so the collection continues with the other artifacts
+			var integrityErr *IntegrityError
+			if errors.As(err, &integrityErr) {
+				if notifyErr := a.NotifyObservers(ctx, events.NewArtifactErrorEvent(executionId, info, err)); notifyErr != nil {
+					slog.Error("Error notifying observers of artifact error", "artifact", info.Name, "error", notifyErr)
+				}
+				return
+			}
 			a.NotifyError(ctx, executionId, err)
 		}
 	}()
@@ -222,11 +393,50 @@ func (a *ArtifactSourceImpl[S, T]) OnArtifactDownloaded(ctx context.Context, inf
 		return err
 	}
 
+	// verify the download before the artifact is collected (if it fails, the download is retried)
+	if err := a.verifyArtifact(ctx, info); err != nil {
+		a.removeTempFile(info.LocalName)
+		return err
+	}
+
+	// determine the version which was downloaded (before any data is removed) - this is stored in the collection
+	// state, so the next collection detects any changes made since the artifact was downloaded
+	version, err := a.downloadedArtifactVersion(ctx, info)
+	if err != nil {
+		a.removeTempFile(info.LocalName)
+		return err
+	}
+
+	// if only the data appended to the artifact is being collected, remove the data already collected
+	if info.CollectFromOffset > 0 {
+		trimmed, err := trimArtifact(a.TempDir, info)
+		if err != nil {
+			a.removeTempFile(info.LocalName)
+			return err
+		}
+		info = trimmed
+	}
+
 	// update the collection state
-	if err := a.CollectionState.OnCollected(info.Identifier(), info.Timestamp); err != nil {
+	if err := a.CollectionState.OnArtifactCollected(info.Identifier(), info.Timestamp, version); err != nil {
+		a.removeTempFile(info.LocalName)
 		return fmt.Errorf("error updating collection state: %w", err)
 	}
 
+	// track the disk space used by the downloaded file until it has been extracted
+	// (if a null loader is set, the artifact is processed elsewhere, e.g. by the plugin using this plugin as a source,
+	// so the file must be left in place)
+	isTempFile := a.isTempFile(info.LocalName) && !a.hasNullLoader()
+	if isTempFile {
+		a.tempDirBudget.add(info.Size)
+		a.notifySourceStatus(ctx, executionId)
+	}
+
+	// the artifact is now handed over for extraction (which decrements the extract wait group)
+	if d, ok := a.activeDownloads.Load(info.Name); ok {
+		d.(*activeDownload).handedOver.Store(true)
+	}
+
 	// extract asynchronously
 	go func() {
 		extractStart := time.Now()
@@ -238,6 +448,13 @@ func (a *ArtifactSourceImpl[S, T]) OnArtifactDownloaded(ctx context.Context, inf
 		activeDuration := time.Since(extractStart)
 		slog.Debug("ArtifactDownloaded - extract complete", "artifact", info.LocalName, "duration (ms)", activeDuration.Milliseconds())
 
+		// remove the downloaded file now it has been extracted (whether or not there was an error)
+		if isTempFile {
+			a.removeTempFile(info.LocalName)
+			a.tempDirBudget.release(info.Size)
+			a.notifySourceStatus(ctx, executionId)
+		}
+
 		// close wait group whether there is an error or not
 		a.artifactExtractWg.Done()
 		if err != nil {
@@ -252,6 +469,68 @@ func (a *ArtifactSourceImpl[S, T]) OnArtifactDownloaded(ctx context.Context, inf
 	return nil
 }
 
+// describeDownloadLimits returns a description of the effective download limits,
+// for inclusion in the source description (this is empty until the source is initialised)
+func (a *ArtifactSourceImpl[S, T]) describeDownloadLimits() string {
+	if a.artifactDownloadLimiter == nil {
+		return ""
+	}
+	res := fmt.Sprintf(" Download limits: %s.", strings.TrimSpace(a.artifactDownloadLimiter.String()))
+	if a.artifactBandwidthLimiter != nil {
+		limit, _ := a.artifactBandwidthLimiter.EffectiveLimits()
+		res += fmt.Sprintf(" Bandwidth limit: %s/s.", humanize.Bytes(uint64(limit)))
+	}
+	return res
+}
+
+// limitBandwidth returns a reader which limits the throughput of the download to the configured bandwidth
+// all downloads share the same bandwidth limit - sources should use this to wrap the data being downloaded
+func (a *ArtifactSourceImpl[S, T]) limitBandwidth(ctx context.Context, r io.Reader) io.Reader {
+	return rate_limiter.NewLimitedReader(ctx, r, a.artifactBandwidthLimiter)
+}
+
+// isTempFile returns whether the file is in the temp directory, i.e. it is a downloaded copy of an artifact,
+// rather than the original artifact (which local sources may extract from directly)
+func (a *ArtifactSourceImpl[S, T]) isTempFile(path string) bool {
+	if a.TempDir == "" {
+		return false
+	}
+	rel, err := filepath.Rel(a.TempDir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (a *ArtifactSourceImpl[S, T]) hasNullLoader() bool {
+	_, isNull := a.Loader.(*artifact_loader.NullLoader)
+	return isNull
+}
+
+// removeTempFile removes the file if it is in the temp directory - original artifacts are never removed
+func (a *ArtifactSourceImpl[S, T]) removeTempFile(path string) {
+	if !a.isTempFile(path) {
+		return
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		slog.Warn("Error removing downloaded artifact", "path", path, "error", err)
+	}
+}
+
+// notifySourceStatus notifies observers of the current temp directory usage and effective download limits
+func (a *ArtifactSourceImpl[S, T]) notifySourceStatus(ctx context.Context, executionId string) {
+	status := events.NewSourceStatusEvent(executionId)
+	if a.tempDirBudget != nil {
+		status.TempDirBytes = a.tempDirBudget.getUsage()
+		status.TempDirMaxBytes = a.tempDirBudget.maxBytes
+	}
+	if a.artifactDownloadLimiter != nil {
+		limit, concurrency := a.artifactDownloadLimiter.EffectiveLimits()
+		status.DownloadRateLimit = float64(limit)
+		status.DownloadConcurrencyLimit = concurrency
+	}
+	if err := a.NotifyObservers(ctx, status); err != nil {
+		slog.Error("Error notifying observers of source status", "error", err)
+	}
+}
+
 // convert a downloaded artifact to a set of raw rows, with optional metadata
 // invoke the artifact loader and any configured mappers to convert the artifact to 'raw' rows,
 // which are streamed to the enricher
@@ -455,40 +734,97 @@ func (a *ArtifactSourceImpl[S, T]) WalkNode(ctx context.Context, targetPath stri
 }
 
 func (a *ArtifactSourceImpl[S, T]) getMetadataAndApplyFilters(targetPath string, basePath string, layouts []string, isDir bool, g *grok.Grok, filterMap map[string]*filter.SqlFilter) (map[string]string, bool, error) {
+	// skip any paths which match an exclude pattern
+	excluded, err := a.isExcluded(targetPath, basePath)
+	if err != nil || excluded {
+		return nil, false, err
+	}
+
+	// use the parsers compiled when the source was initialised
+	// (if the source has not been initialised, compile each layout using the parser provided)
+	compile := compileWith(g)
+	if a.layoutCache != nil {
+		compile = a.layoutCache.compile
+	}
+
 	// if the original file layout had any optional segments, we will have expanded them into multiple potential layouts
 	// try each one and use the first one which matches
+	// (if multiple file layouts are configured, these are tried in order)
 	var match bool
 	var metadata map[string]string
-	var err error
 	for _, layout := range layouts {
 		// check whether this path satisfies the layout and filters
 
 		// if we are a directory and we are not satisfied, skip the directory by returning fs.SkipDir
-		match, metadata, err = getPathMetadata(targetPath, basePath, layout, isDir, g)
+		match, metadata, err = getPathMetadata(targetPath, basePath, layout, isDir, compile)
 		if err != nil {
 			return nil, false, err
 		}
 		if match {
+			// for files, record which file layout matched
+			if !isDir {
+				metadata[constants.ArtifactMetadataFileLayout] = a.fileLayoutSource(layout)
+			}
 			break
 		}
 	}
 
 	// check if the path matches the layout and if so, are filters satisfied
-	satisfied := match && metadataSatisfiesFilters(metadata, filterMap)
+	// (both those passed by the source and those in config)
+	satisfied := match && metadataSatisfiesFilters(metadata, filterMap) && metadataSatisfiesFilters(metadata, a.Config.GetFilterMap())
 
 	// if we have a from time, check whether that excludes this directory
 	if satisfied && isDir && !a.FromTime.IsZero() {
 		satisfied = dirSatisfiesFromTime(a.FromTime, metadata)
 	}
+	// similarly, if we have a to time, check whether the directory is entirely after it
+	if satisfied && isDir && !a.ToTime.IsZero() {
+		satisfied = dirSatisfiesToTime(a.ToTime, metadata)
+	}
 
 	return metadata, satisfied, nil
 }
 
+// isExcluded returns whether the path (relative to the base path) matches any of the exclude patterns in config
+func (a *ArtifactSourceImpl[S, T]) isExcluded(targetPath, basePath string) (bool, error) {
+	excludePatterns := a.Config.GetExclude()
+	if len(excludePatterns) == 0 {
+		return false, nil
+	}
+	relPath, err := filepath.Rel(basePath, targetPath)
+	if err != nil {
+		return false, err
+	}
+	relPath = filepath.ToSlash(relPath)
+	for _, pattern := range excludePatterns {
+		match, err := doublestar.Match(pattern, relPath)
+		if err != nil {
+			return false, fmt.Errorf("invalid exclude pattern '%s': %w", pattern, err)
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fileLayoutSource returns the configured file layout which the (expanded) layout was expanded from
+func (a *ArtifactSourceImpl[S, T]) fileLayoutSource(layout string) string {
+	if fileLayout, ok := a.fileLayoutSources[layout]; ok {
+		return fileLayout
+	}
+	return layout
+}
+
 func (a *ArtifactSourceImpl[S, T]) walkFileNode(ctx context.Context, targetPath string, satisfied bool, metadata map[string]string) error {
 	// if the pattern is not satisfied, skip the file
 	if !satisfied {
 		return nil
 	}
+	// digest files are not artifacts
+	if checksumConfig := a.Config.GetChecksumConfig(); checksumConfig != nil && strings.HasSuffix(targetPath, checksumConfig.GetFileSuffix()) {
+		return nil
+	}
 
 	// so we are satisfied - determine whether we should collect this artifact
 
@@ -502,10 +838,14 @@ func (a *ArtifactSourceImpl[S, T]) walkFileNode(ctx context.Context, targetPath
 	sourceEnrichment := schema.NewSourceEnrichment(metadata)
 
 	// create an artifact info - this will parse the timestamp of the artifact from the source enrichment metadata
-	artifactInfo, err := types.NewArtifactInfo(targetPath, sourceEnrichment, a.CollectionState.GetGranularity())
+	artifactInfo, err := types.NewArtifactInfo(targetPath, sourceEnrichment, a.artifactGranularity(metadata))
 	if err != nil {
 		return err
 	}
+	// if configured, verify the artifact against the digest file stored alongside it
+	if checksumConfig := a.Config.GetChecksumConfig(); checksumConfig != nil {
+		artifactInfo.DigestFile = checksumConfig.GetDigestFile(targetPath)
+	}
 
 	// if the artifact has a timestamp, and  we have a from time, check if the artifact is newer than the from time
 	if !artifactInfo.Timestamp.IsZero() && !a.FromTime.IsZero() {
@@ -513,11 +853,18 @@ func (a *ArtifactSourceImpl[S, T]) walkFileNode(ctx context.Context, targetPath
 			return nil
 		}
 	}
+	// likewise, if we have a to time, check the artifact is not newer than the to time
+	if !artifactInfo.Timestamp.IsZero() && !a.ToTime.IsZero() {
+		if artifactInfo.Timestamp.After(a.ToTime) {
+			return nil
+		}
+	}
 
 	// now check with the collection state if we should collect this artifact
-	if !a.CollectionState.ShouldCollect(artifactInfo.Identifier(), artifactInfo.Timestamp) {
+	shouldCollect, err := a.shouldCollectArtifact(ctx, artifactInfo)
+	if err != nil || !shouldCollect {
 		// do not collect - just return
-		return nil
+		return err
 	}
 
 	// so we SHOULD collect -  notify observers of the discovered artifact
@@ -0,0 +1,165 @@
+package artifact_source
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// ArtifactVersioner may be implemented by an ArtifactSource to support detecting artifacts which have changed
+// since they were collected (see the on_change config property)
+type ArtifactVersioner interface {
+	GetArtifactVersion(ctx context.Context, name string) (*types.ArtifactVersion, error)
+}
+
+// ArtifactChecker may be implemented by an ArtifactSource which must check an artifact before it is collected,
+// e.g. with a request to determine whether a previously collected artifact has changed
+// CheckArtifact is only called for artifacts which match the file layout, filters and collection time range
+type ArtifactChecker interface {
+	CheckArtifact(ctx context.Context, info *types.ArtifactInfo) (bool, error)
+}
+
+// shouldCollectArtifact asks the source (if it implements ArtifactChecker) and then the collection state whether
+// the artifact should be collected
+// if a change policy is configured and the source supports versions, artifacts which have changed since
+// they were collected are collected again - either in full or only the appended data, depending on the policy
+func (a *ArtifactSourceImpl[S, T]) shouldCollectArtifact(ctx context.Context, info *types.ArtifactInfo) (bool, error) {
+	if checker, ok := a.Source.(ArtifactChecker); ok {
+		collect, err := checker.CheckArtifact(ctx, info)
+		if err != nil || !collect {
+			return false, err
+		}
+	}
+
+	policy := a.Config.GetChangePolicy()
+	versioner, ok := a.Source.(ArtifactVersioner)
+	if policy == artifact_source_config.ChangePolicyIgnore || !ok {
+		return a.CollectionState.ShouldCollect(info.Identifier(), info.Timestamp), nil
+	}
+
+	version, err := versioner.GetArtifactVersion(ctx, info.Name)
+	if err != nil {
+		return false, fmt.Errorf("error getting version of artifact %s: %w", info.Name, err)
+	}
+	res, prev := a.CollectionState.ShouldCollectArtifact(info.Identifier(), info.Timestamp, version)
+	switch res {
+	case collection_state.ShouldCollectNew:
+		return true, nil
+	case collection_state.ShouldCollectChanged:
+		slog.Info("Artifact has changed since it was collected", "artifact", info.Name, "policy", policy, "previous size", prev.Size, "size", version.Size)
+		// if the artifact has shrunk, it has been rewritten - collect it in full
+		// (compressed artifacts cannot be split, so are always collected in full)
+		if policy == artifact_source_config.ChangePolicyAppend && version.Size >= prev.Size && !artifact_source_config.IsCompressedPath(info.Name) {
+			info.CollectFromOffset = prev.Size
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// downloadedArtifactVersion returns the version of the downloaded artifact, to be stored in the collection state
+// (or nil if changed artifacts are not being detected)
+// sources should set the version when the artifact is downloaded - if the version is not set, the current version is
+// requested from the source, with the size of the downloaded data
+func (a *ArtifactSourceImpl[S, T]) downloadedArtifactVersion(ctx context.Context, info *types.DownloadedArtifactInfo) (*types.ArtifactVersion, error) {
+	versioner, ok := a.Source.(ArtifactVersioner)
+	if a.Config.GetChangePolicy() == artifact_source_config.ChangePolicyIgnore || !ok {
+		return nil, nil
+	}
+	if info.Version != nil {
+		return info.Version, nil
+	}
+	version, err := versioner.GetArtifactVersion(ctx, info.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting version of artifact %s: %w", info.Name, err)
+	}
+	if version == nil {
+		return nil, nil
+	}
+	res := *version
+	res.Size = info.Size
+	return &res, nil
+}
+
+// trimArtifact copies the lines starting at or after info.CollectFromOffset to a new file in the temp directory,
+// so only the data appended since the artifact was last collected is extracted
+func trimArtifact(tempDir string, info *types.DownloadedArtifactInfo) (*types.DownloadedArtifactInfo, error) {
+	// the artifact may have been rewritten since discovery - if so, collect it in full
+	if info.Size < info.CollectFromOffset {
+		slog.Info("Artifact is smaller than the data already collected - collecting in full", "artifact", info.Name)
+		return info, nil
+	}
+
+	src, err := os.Open(info.LocalName)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	// if the previous collection stopped part way through a line (i.e. it read a partially written final line),
+	// skip to the start of the next line so the remainder of that line is not collected as a row
+	reader, err := seekToLineStart(src, info.CollectFromOffset)
+	if err != nil {
+		return nil, fmt.Errorf("error seeking to appended data of %s: %w", info.Name, err)
+	}
+
+	if err := os.MkdirAll(tempDir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating temp directory %s: %w", tempDir, err)
+	}
+	// keep the file name as a suffix so the loader is chosen based on the original extension
+	dst, err := os.CreateTemp(tempDir, "*-"+filepath.Base(info.LocalName))
+	if err != nil {
+		return nil, fmt.Errorf("error creating temp file for %s: %w", info.Name, err)
+	}
+	size, err := io.Copy(dst, reader)
+	_ = src.Close()
+	closeErr := dst.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return nil, fmt.Errorf("error copying appended data of %s: %w", info.Name, err)
+	}
+
+	// remove the downloaded file - unless it is the original artifact (i.e. a local file which was not copied)
+	if info.LocalName != info.Name {
+		_ = os.Remove(info.LocalName)
+	}
+	res := *info
+	res.LocalName = dst.Name()
+	res.Size = size
+	return &res, nil
+}
+
+// seekToLineStart returns a reader positioned at the first line which starts at or after offset
+func seekToLineStart(f *os.File, offset int64) (io.Reader, error) {
+	if offset == 0 {
+		return f, nil
+	}
+	// check whether the data before the offset ends with a complete line
+	if _, err := f.Seek(offset-1, io.SeekStart); err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(f)
+	b, err := reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if b != '\n' {
+		// skip the remainder of the partial line - if there is no newline, there is no complete line to collect
+		if _, err := reader.ReadString('\n'); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+	return reader, nil
+}
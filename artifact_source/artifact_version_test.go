@@ -0,0 +1,43 @@
+package artifact_source
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func Test_trimArtifact(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int64
+		want   string
+	}{
+		{name: "appended data", offset: 7, want: "line 2\n"},
+		{name: "partial line previously collected", offset: 3, want: "line 2\n"},
+		{name: "offset at newline", offset: 6, want: "line 2\n"},
+		{name: "partial final line", offset: 10, want: ""},
+		{name: "nothing appended", offset: 14, want: ""},
+		{name: "rewritten artifact", offset: 20, want: "line 1\nline 2\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a local artifact, which must not be removed
+			artifactPath := filepath.Join(t.TempDir(), "app.log")
+			writeTestFile(t, artifactPath, "line 1\nline 2\n")
+			info := types.NewDownloadedArtifactInfo(&types.ArtifactInfo{Name: artifactPath, CollectFromOffset: tt.offset}, artifactPath, 14)
+
+			got, err := trimArtifact(t.TempDir(), info)
+			if !assert.NoError(t, err) {
+				return
+			}
+			data, err := os.ReadFile(got.LocalName)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+			assert.Equal(t, int64(len(tt.want)), got.Size)
+			assert.FileExists(t, artifactPath)
+		})
+	}
+}
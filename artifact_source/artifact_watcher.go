@@ -0,0 +1,224 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// the minimum interval at which we check whether changed files have settled
+const minWatchSettleCheckInterval = 50 * time.Millisecond
+
+// WatchPaths watches the given base paths for files which are created or modified, calling visit for each file
+// once it has been unchanged for the settle delay. New directories are passed to visit as they appear and,
+// unless visit returns fs.SkipDir, are watched in turn.
+// A base path may be a single file, in which case only that file is watched.
+// WatchPaths blocks until the context is cancelled.
+func (a *ArtifactSourceImpl[S, T]) WatchPaths(ctx context.Context, basePaths []string, visit func(targetPath, basePath string, isDir bool) error) error {
+	settleDelay := a.WatchConfig.GetSettleDelay()
+
+	w, err := newArtifactWatcher(basePaths, visit)
+	if err != nil {
+		return err
+	}
+	defer w.close()
+
+	slog.Info("Watching for new artifacts", "paths", basePaths, "settle delay", settleDelay)
+
+	// check for settled files at a fraction of the settle delay
+	ticker := time.NewTicker(max(settleDelay/4, minWatchSettleCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Watch cancelled")
+			return nil
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return nil
+			}
+			w.onEvent(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return nil
+			}
+			slog.Warn("Error watching for new artifacts", "error", err)
+		case <-ticker.C:
+			if err := w.processSettled(time.Now().Add(-settleDelay)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// artifactWatcher watches directories using fsnotify, tracking changed paths until they settle
+type artifactWatcher struct {
+	watcher *fsnotify.Watcher
+	visit   func(targetPath, basePath string, isDir bool) error
+	// map of watched directory to the base path it belongs to
+	watchedDirs map[string]string
+	// if a base path is a file, map of the file to its parent directory (which is watched)
+	watchedFiles map[string]string
+	// map of changed path to the time of the last change
+	pending map[string]time.Time
+}
+
+func newArtifactWatcher(basePaths []string, visit func(targetPath, basePath string, isDir bool) error) (*artifactWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error creating file watcher: %w", err)
+	}
+	w := &artifactWatcher{
+		watcher:      watcher,
+		visit:        visit,
+		watchedDirs:  make(map[string]string),
+		watchedFiles: make(map[string]string),
+		pending:      make(map[string]time.Time),
+	}
+
+	for _, basePath := range basePaths {
+		basePath = filepath.Clean(basePath)
+		stat, err := os.Stat(basePath)
+		if err != nil {
+			w.close()
+			return nil, err
+		}
+		if !stat.IsDir() {
+			// watch the parent directory, but only for changes to this file
+			w.watchedFiles[basePath] = filepath.Dir(basePath)
+			if err := w.watcher.Add(filepath.Dir(basePath)); err != nil {
+				w.close()
+				return nil, fmt.Errorf("error watching %s: %w", basePath, err)
+			}
+			continue
+		}
+		// NOTE: existing files are not treated as changed - they are collected by the initial discovery
+		if err := w.watchDir(basePath, basePath, false); err != nil {
+			w.close()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// watchDir adds a watch for the directory and all subdirectories accepted by visit
+// if markFilesChanged is set, any files already in the directories are treated as changed
+func (w *artifactWatcher) watchDir(dir, basePath string, markFilesChanged bool) error {
+	if _, ok := w.watchedDirs[dir]; ok {
+		return nil
+	}
+	if err := w.watcher.Add(dir); err != nil {
+		return fmt.Errorf("error watching %s: %w", dir, err)
+	}
+	w.watchedDirs[dir] = basePath
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			if markFilesChanged {
+				w.pending[filepath.Join(dir, entry.Name())] = time.Now()
+			}
+			continue
+		}
+		subDir := filepath.Join(dir, entry.Name())
+		err := w.visit(subDir, basePath, true)
+		if errors.Is(err, fs.SkipDir) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := w.watchDir(subDir, basePath, markFilesChanged); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *artifactWatcher) onEvent(event fsnotify.Event) {
+	switch {
+	case event.Has(fsnotify.Create), event.Has(fsnotify.Write):
+		if _, ok := w.basePathFor(event.Name); ok {
+			w.pending[event.Name] = time.Now()
+		}
+	case event.Has(fsnotify.Remove), event.Has(fsnotify.Rename):
+		delete(w.pending, event.Name)
+		if _, ok := w.watchedDirs[event.Name]; ok {
+			// the watch is removed automatically
+			delete(w.watchedDirs, event.Name)
+		}
+	}
+}
+
+// processSettled visits all pending paths which have not changed since the given time
+func (w *artifactWatcher) processSettled(settledBefore time.Time) error {
+	for path, lastChange := range w.pending {
+		if lastChange.After(settledBefore) {
+			continue
+		}
+		delete(w.pending, path)
+
+		basePath, ok := w.basePathFor(path)
+		if !ok {
+			continue
+		}
+		stat, err := os.Stat(path)
+		if err != nil {
+			// the file may have been removed since the event
+			continue
+		}
+
+		if !stat.IsDir() {
+			if !stat.Mode().IsRegular() {
+				continue
+			}
+			if err := w.visit(path, basePath, false); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// a new directory - watch it if the layout is satisfied
+		err = w.visit(path, basePath, true)
+		if errors.Is(err, fs.SkipDir) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// files may have been created in the directory before the watch was added - treat them as changed
+		if err := w.watchDir(path, basePath, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// basePathFor returns the base path which the changed path belongs to
+func (w *artifactWatcher) basePathFor(path string) (string, bool) {
+	// if a single file is being watched, only changes to that file are relevant
+	if parent, ok := w.watchedFiles[path]; ok {
+		return parent, true
+	}
+	// otherwise the parent directory must be watched
+	// (this excludes other files in the parent directory of a single watched file)
+	basePath, ok := w.watchedDirs[filepath.Dir(path)]
+	return basePath, ok
+}
+
+func (w *artifactWatcher) close() {
+	if err := w.watcher.Close(); err != nil {
+		slog.Warn("Error closing file watcher", "error", err)
+	}
+}
@@ -0,0 +1,79 @@
+package artifact_source
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+)
+
+func TestArtifactSourceImpl_WatchPaths(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "skip"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	a := &ArtifactSourceImpl[*FileSystemSourceConfig, *EmptyConnection]{
+		WatchConfig: &artifact_source_config.WatchConfig{SettleDelay: utils.ToPointer("100ms")},
+	}
+
+	visited := make(chan string, 10)
+	visit := func(targetPath, basePath string, isDir bool) error {
+		rel, err := filepath.Rel(basePath, targetPath)
+		if err != nil {
+			return err
+		}
+		if isDir {
+			if rel == "skip" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		visited <- rel
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- a.WatchPaths(ctx, []string{root}, visit)
+	}()
+	// give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+
+	write := func(path string) {
+		if err := os.WriteFile(filepath.Join(root, path), []byte("data"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// files in the skipped directory are not visited
+	write("skip/ignored.log")
+	write("1.log")
+	// a file created in a new directory is visited
+	if err := os.Mkdir(filepath.Join(root, "new"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	write("new/2.log")
+
+	got := make(map[string]struct{})
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case path := <-visited:
+			got[path] = struct{}{}
+		case <-timeout:
+			t.Fatalf("timed out waiting for files, got %v", got)
+		}
+	}
+	assert.Equal(t, map[string]struct{}{"1.log": {}, "new/2.log": {}}, got)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Empty(t, visited)
+}
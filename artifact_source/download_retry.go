@@ -0,0 +1,303 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/events"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+const (
+	defaultDownloadMaxAttempts    = 3
+	defaultDownloadInitialBackoff = 1 * time.Second
+	defaultDownloadMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy defines how failed artifact downloads are retried
+type RetryPolicy struct {
+	// the maximum number of download attempts (including the first) - a value of 1 disables retries
+	MaxAttempts int
+	// the backoff before the first retry - this doubles for each subsequent retry, up to MaxBackoff
+	// a random jitter is applied, so the actual delay is between zero and the backoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// IsRetryable determines whether a download error is retryable
+	// if not set, the source is asked (if it implements RetryableErrorClassifier), falling back to IsRetryableError
+	IsRetryable func(error) bool
+}
+
+// DefaultRetryPolicy returns the retry policy used if none is specified (see WithRetryPolicy)
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    defaultDownloadMaxAttempts,
+		InitialBackoff: defaultDownloadInitialBackoff,
+		MaxBackoff:     defaultDownloadMaxBackoff,
+	}
+}
+
+// backoff returns the (jittered) delay before the given retry (starting at 1)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// full jitter - spread retries evenly across the backoff window
+	return rand.N(backoff + 1)
+}
+
+// RetryableErrorClassifier may be implemented by an ArtifactSource to determine which of its download errors
+// are retryable (for example based on the status code of an error response)
+type RetryableErrorClassifier interface {
+	IsRetryableError(err error) bool
+}
+
+// ThrottlingErrorClassifier may be implemented by an ArtifactSource to identify download errors which indicate
+// the server is throttling requests (for example HTTP 429 responses)
+// throttling is reported to the download limiter, which reduces the download rate and concurrency if it is adaptive
+type ThrottlingErrorClassifier interface {
+	// IsThrottlingError returns whether the error indicates throttling,
+	// and the delay the server asked for before retrying (zero if none)
+	IsThrottlingError(err error) (bool, time.Duration)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may be a number of seconds or an HTTP date
+// returns zero if the value is empty or invalid
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
+
+// RetryableError wraps an error to mark it as retryable
+type RetryableError struct {
+	Err error
+}
+
+func (e *RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryableError is the default retryable error classification: errors explicitly marked as retryable
+// (see RetryableError), partial downloads, integrity failures, network errors and unexpected EOFs are retryable
+// cancellation is never retryable
+func IsRetryableError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var retryableErr *RetryableError
+	var partialErr *PartialDownloadError
+	var integrityErr *IntegrityError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &retryableErr), errors.As(err, &partialErr), errors.As(err, &integrityErr), errors.As(err, &netErr):
+		return true
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.EPIPE):
+		return true
+	}
+	return false
+}
+
+// PartialDownloadError is returned (wrapped or otherwise) by DownloadArtifact when a download fails part way through
+// the first Offset bytes of the artifact have been written to LocalPath
+//
+// If the source implements ResumableArtifactSource, the retry resumes the download from the offset,
+// otherwise the partial file is removed and the download starts again
+type PartialDownloadError struct {
+	LocalPath string
+	Offset    int64
+	Err       error
+}
+
+func (e *PartialDownloadError) Error() string {
+	return fmt.Sprintf("download failed after %d bytes: %s", e.Offset, e.Err.Error())
+}
+
+func (e *PartialDownloadError) Unwrap() error {
+	return e.Err
+}
+
+// ResumableArtifactSource may be implemented by an ArtifactSource which supports ranged downloads
+type ResumableArtifactSource interface {
+	// ResumeArtifactDownload continues a download which failed with a PartialDownloadError,
+	// appending the artifact data from partial.Offset onwards to partial.LocalPath
+	// (if the artifact has changed since the download started, the whole artifact should be downloaded again)
+	// as with DownloadArtifact, OnArtifactDownloaded must be called once the download is complete
+	ResumeArtifactDownload(ctx context.Context, info *types.ArtifactInfo, partial *PartialDownloadError) error
+}
+
+// copyArtifactData copies the artifact data to the (open) local file, which already contains offset bytes,
+// and closes the file
+// if the copy fails part way through, a PartialDownloadError is returned so the download may be resumed,
+// otherwise the file is removed
+// returns the total size of the local file
+func copyArtifactData(dst *os.File, src io.Reader, offset int64) (int64, error) {
+	n, err := io.Copy(dst, src)
+	closeErr := dst.Close()
+	if err != nil {
+		// if we wrote anything (or were resuming), the download can be resumed
+		if offset+n > 0 && closeErr == nil {
+			return 0, &PartialDownloadError{LocalPath: dst.Name(), Offset: offset + n, Err: err}
+		}
+		_ = os.Remove(dst.Name())
+		return 0, err
+	}
+	if closeErr != nil {
+		return 0, closeErr
+	}
+	return offset + n, nil
+}
+
+// activeDownload tracks the state of an in progress download
+type activeDownload struct {
+	// set once the source has called OnArtifactDownloaded - from then on, the extraction owns the artifact
+	handedOver atomic.Bool
+}
+
+// downloadArtifact downloads the artifact using the source, retrying retryable errors according to the retry policy
+// if the download fails (or the source does not call OnArtifactDownloaded), the extract wait group is decremented,
+// as the artifact will not be extracted
+func (a *ArtifactSourceImpl[S, T]) downloadArtifact(ctx context.Context, executionId string, info *types.ArtifactInfo) error {
+	policy := a.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	download := &activeDownload{}
+	a.activeDownloads.Store(info.Name, download)
+	defer a.activeDownloads.Delete(info.Name)
+
+	var partial *PartialDownloadError
+	for attempt := 1; ; attempt++ {
+		var err error
+		if resumable, ok := a.Source.(ResumableArtifactSource); ok && partial != nil {
+			slog.Debug("Resuming artifact download", "artifact", info.Name, "offset", partial.Offset)
+			err = resumable.ResumeArtifactDownload(ctx, info, partial)
+		} else {
+			if partial != nil {
+				// the source cannot resume - discard the partial download and start again
+				_ = os.Remove(partial.LocalPath)
+			}
+			err = a.Source.DownloadArtifact(ctx, info)
+		}
+
+		// once the artifact has been handed over for extraction, any error was raised after the download completed
+		// so must not be retried
+		if download.handedOver.Load() {
+			a.onDownloadSucceeded(ctx, executionId)
+			return err
+		}
+		if err == nil {
+			// the source chose not to collect the artifact
+			a.onDownloadSucceeded(ctx, executionId)
+			a.artifactExtractWg.Done()
+			return nil
+		}
+
+		// if the server is throttling us, reduce the download limits
+		throttled, retryAfter := a.isThrottlingError(err)
+		if throttled {
+			a.onDownloadThrottled(ctx, executionId, retryAfter)
+		}
+
+		partial = nil
+		errors.As(err, &partial)
+		if attempt >= policy.MaxAttempts || !a.isRetryableError(policy, err) {
+			if partial != nil {
+				_ = os.Remove(partial.LocalPath)
+			}
+			a.artifactExtractWg.Done()
+			return err
+		}
+
+		backoff := policy.backoff(attempt)
+		// respect any delay requested by the server
+		if throttled && retryAfter > backoff {
+			backoff = retryAfter
+		}
+		slog.Warn("Artifact download failed - retrying", "artifact", info.Name, "attempt", attempt, "backoff", backoff, "error", err)
+		if notifyErr := a.NotifyObservers(ctx, events.NewArtifactDownloadRetriedEvent(executionId, info, attempt, err)); notifyErr != nil {
+			slog.Error("Error notifying observers of download retry", "artifact", info.Name, "error", notifyErr)
+		}
+
+		select {
+		case <-ctx.Done():
+			if partial != nil {
+				_ = os.Remove(partial.LocalPath)
+			}
+			a.artifactExtractWg.Done()
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// isRetryableError classifies a download error using (in order of preference) the retry policy,
+// the source (if it implements RetryableErrorClassifier) or the default classification
+func (a *ArtifactSourceImpl[S, T]) isRetryableError(policy *RetryPolicy, err error) bool {
+	if policy.IsRetryable != nil {
+		return policy.IsRetryable(err)
+	}
+	if classifier, ok := a.Source.(RetryableErrorClassifier); ok {
+		return classifier.IsRetryableError(err)
+	}
+	return IsRetryableError(err)
+}
+
+// isThrottlingError returns whether the download error indicates the server is throttling requests
+// (if the source implements ThrottlingErrorClassifier), and the delay the server asked for
+func (a *ArtifactSourceImpl[S, T]) isThrottlingError(err error) (bool, time.Duration) {
+	classifier, ok := a.Source.(ThrottlingErrorClassifier)
+	if !ok {
+		return false, 0
+	}
+	return classifier.IsThrottlingError(err)
+}
+
+// onDownloadThrottled reports throttling to the download limiter, notifying observers if the limits changed
+func (a *ArtifactSourceImpl[S, T]) onDownloadThrottled(ctx context.Context, executionId string, retryAfter time.Duration) {
+	if a.artifactDownloadLimiter == nil || !a.artifactDownloadLimiter.OnThrottled(retryAfter) {
+		return
+	}
+	limit, concurrency := a.artifactDownloadLimiter.EffectiveLimits()
+	slog.Warn("Artifact downloads throttled - reducing download limits", "rate", limit, "concurrency", concurrency, "retry after", retryAfter)
+	a.notifySourceStatus(ctx, executionId)
+}
+
+// onDownloadSucceeded reports a successful download to the download limiter, notifying observers if the limits changed
+func (a *ArtifactSourceImpl[S, T]) onDownloadSucceeded(ctx context.Context, executionId string) {
+	if a.artifactDownloadLimiter == nil || !a.artifactDownloadLimiter.OnSuccess() {
+		return
+	}
+	limit, concurrency := a.artifactDownloadLimiter.EffectiveLimits()
+	slog.Debug("Increasing download limits", "rate", limit, "concurrency", concurrency)
+	a.notifySourceStatus(ctx, executionId)
+}
@@ -0,0 +1,189 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/events"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// retryTestSource is an ArtifactSource whose downloads return the given errors in turn
+// if an error is a PartialDownloadError, the partial file is written to the temp directory
+type retryTestSource struct {
+	ArtifactSourceImpl[*FileSystemSourceConfig, *EmptyConnection]
+
+	errs     []error
+	calls    int
+	partials []string
+}
+
+func newRetryTestSource(t *testing.T, errs ...error) *retryTestSource {
+	s := &retryTestSource{errs: errs}
+	s.Source = s
+	s.TempDir = t.TempDir()
+	s.RetryPolicy = &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	return s
+}
+
+func (s *retryTestSource) DownloadArtifact(_ context.Context, _ *types.ArtifactInfo) error {
+	return s.nextError()
+}
+
+func (s *retryTestSource) nextError() error {
+	s.calls++
+	if s.calls > len(s.errs) {
+		return nil
+	}
+	err := s.errs[s.calls-1]
+	var partial *PartialDownloadError
+	if errors.As(err, &partial) {
+		partial.LocalPath = filepath.Join(s.TempDir, "partial.log")
+		_ = os.WriteFile(partial.LocalPath, make([]byte, partial.Offset), 0644)
+		s.partials = append(s.partials, partial.LocalPath)
+	}
+	return err
+}
+
+// resumableRetryTestSource additionally implements ResumableArtifactSource
+type resumableRetryTestSource struct {
+	*retryTestSource
+	resumedFrom []int64
+}
+
+func (s *resumableRetryTestSource) ResumeArtifactDownload(_ context.Context, _ *types.ArtifactInfo, partial *PartialDownloadError) error {
+	s.resumedFrom = append(s.resumedFrom, partial.Offset)
+	return s.nextError()
+}
+
+type retryTestObserver struct {
+	mut      sync.Mutex
+	attempts []int
+}
+
+func (o *retryTestObserver) Notify(_ context.Context, e events.Event) error {
+	if r, ok := e.(*events.ArtifactDownloadRetried); ok {
+		o.mut.Lock()
+		o.attempts = append(o.attempts, r.Attempt)
+		o.mut.Unlock()
+	}
+	return nil
+}
+
+func TestArtifactSourceImpl_downloadArtifact(t *testing.T) {
+	notFound := errors.New("not found")
+
+	tests := []struct {
+		name      string
+		errs      []error
+		resumable bool
+		wantErr   error
+		wantCalls int
+		// the offsets any resumed downloads were resumed from
+		wantResumedFrom []int64
+		wantRetryEvents []int
+	}{
+		{
+			name:      "success",
+			wantCalls: 1,
+		},
+		{
+			name:            "retryable error then success",
+			errs:            []error{io.ErrUnexpectedEOF},
+			wantCalls:       2,
+			wantRetryEvents: []int{1},
+		},
+		{
+			name:            "attempts exhausted",
+			errs:            []error{io.ErrUnexpectedEOF, io.ErrUnexpectedEOF, io.ErrUnexpectedEOF},
+			wantErr:         io.ErrUnexpectedEOF,
+			wantCalls:       3,
+			wantRetryEvents: []int{1, 2},
+		},
+		{
+			name:      "not retryable",
+			errs:      []error{notFound},
+			wantErr:   notFound,
+			wantCalls: 1,
+		},
+		{
+			name:            "partial download restarted",
+			errs:            []error{&PartialDownloadError{Offset: 10, Err: io.ErrUnexpectedEOF}},
+			wantCalls:       2,
+			wantRetryEvents: []int{1},
+		},
+		{
+			name:            "partial download resumed",
+			errs:            []error{&PartialDownloadError{Offset: 10, Err: io.ErrUnexpectedEOF}, &PartialDownloadError{Offset: 20, Err: io.ErrUnexpectedEOF}},
+			resumable:       true,
+			wantCalls:       3,
+			wantResumedFrom: []int64{10, 20},
+			wantRetryEvents: []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRetryTestSource(t, tt.errs...)
+			var resumable *resumableRetryTestSource
+			if tt.resumable {
+				resumable = &resumableRetryTestSource{retryTestSource: s}
+				s.Source = resumable
+			}
+			observer := &retryTestObserver{}
+			_ = s.AddObserver(observer)
+
+			s.artifactExtractWg.Add(1)
+			err := s.downloadArtifact(context.Background(), "exec", &types.ArtifactInfo{Name: "test.log"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCalls, s.calls)
+			assert.Equal(t, tt.wantRetryEvents, observer.attempts)
+			if resumable != nil {
+				assert.Equal(t, tt.wantResumedFrom, resumable.resumedFrom)
+			}
+			// the wait group must have been decremented, as the artifact was not handed over for extraction
+			assertWaitGroupDone(t, &s.artifactExtractWg)
+			// partial downloads must be removed if they are not resumed
+			if !tt.resumable {
+				for _, p := range s.partials {
+					assert.NoFileExists(t, p)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	maxBackoffs := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, want := range maxBackoffs {
+		for range 20 {
+			got := p.backoff(i + 1)
+			assert.GreaterOrEqual(t, got, time.Duration(0))
+			assert.LessOrEqual(t, got, want)
+		}
+	}
+}
+
+func assertWaitGroupDone(t *testing.T, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("wait group was not decremented")
+	}
+}
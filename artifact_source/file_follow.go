@@ -0,0 +1,239 @@
+package artifact_source
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+)
+
+// the number of bytes at the start of a followed file used to fingerprint it
+const followFingerprintLength = 1024
+
+// rotated files with these extensions have been compressed, so cannot be used to finish reading a rotated file
+var compressedExtensions = []string{".gz", ".bz2", ".xz", ".zst", ".zip"}
+
+// followSegment is a byte range of a file which must be read
+type followSegment struct {
+	path string
+	from int64
+	to   int64
+}
+
+// followPlan describes the data which must be read to bring a followed file up to date
+type followPlan struct {
+	path  string
+	inode uint64
+	// the segments to read, in order - any remaining data from a rotated file is read before the live file
+	segments []followSegment
+	// the offset in the live file we start reading from
+	from int64
+}
+
+// planFollow determines which data must be read from the followed file, based on its previous state
+// - if the file has been rotated by rename, the remainder of the rotated file (found by inode) is read first
+// - if the file has been truncated (copytruncate), the remainder of the rotated copy is read first
+// returns nil if there is no new data
+func planFollow(path string, prev *collection_state.FollowedFileState) (*followPlan, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	plan := &followPlan{
+		path:  path,
+		inode: fileInode(stat),
+	}
+	size := stat.Size()
+
+	switch {
+	case prev == nil:
+		// first time we have seen this file - read from the start
+	case prev.Inode != 0 && plan.inode != 0 && prev.Inode != plan.inode:
+		// the file has been rotated by rename - finish reading the previous file if we can find it
+		if rotated, rotatedSize := findFileByInode(filepath.Dir(path), prev.Inode); rotated != "" {
+			if rotatedSize > prev.Offset {
+				plan.segments = append(plan.segments, followSegment{path: rotated, from: prev.Offset, to: rotatedSize})
+			}
+		} else {
+			slog.Warn("Followed file has been rotated but the rotated file cannot be found", "path", path)
+		}
+	case size < prev.Offset || !fingerprintMatches(path, prev):
+		// the file has been truncated in place - finish reading the rotated copy if we can find it
+		if rotated, rotatedSize := findRotatedCopy(path, prev); rotated != "" {
+			if rotatedSize > prev.Offset {
+				plan.segments = append(plan.segments, followSegment{path: rotated, from: prev.Offset, to: rotatedSize})
+			}
+		} else {
+			slog.Warn("Followed file has been truncated but the rotated copy cannot be found", "path", path)
+		}
+	default:
+		// the file has been appended to (or is unchanged)
+		plan.from = prev.Offset
+	}
+
+	if size > plan.from {
+		plan.segments = append(plan.segments, followSegment{path: path, from: plan.from, to: size})
+	}
+	if len(plan.segments) == 0 {
+		return nil, nil
+	}
+	return plan, nil
+}
+
+// copyTo writes the planned data to w and returns the new state of the followed file
+// only complete lines are read from the live file - any partial last line is read next time
+func (p *followPlan) copyTo(w io.Writer) (*collection_state.FollowedFileState, error) {
+	offset := p.from
+	for _, segment := range p.segments {
+		end, err := copyFollowSegment(segment, segment.path == p.path, w)
+		if err != nil {
+			return nil, err
+		}
+		if segment.path == p.path {
+			offset = end
+		}
+	}
+
+	fingerprint, err := fileFingerprint(p.path, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &collection_state.FollowedFileState{
+		Inode:       p.inode,
+		Offset:      offset,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// copyFollowSegment copies the segment to w, returning the offset copied up to
+// if completeLinesOnly is set, the copy stops after the last newline in the segment
+func copyFollowSegment(segment followSegment, completeLinesOnly bool, w io.Writer) (int64, error) {
+	f, err := os.Open(segment.path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	end := segment.to
+	if completeLinesOnly {
+		end, err = lastLineEnd(f, segment.from, segment.to)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if _, err := io.Copy(w, io.NewSectionReader(f, segment.from, end-segment.from)); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+// lastLineEnd returns the offset immediately after the last newline in the range [from, to) of the file,
+// or from if the range contains no newline
+func lastLineEnd(f io.ReaderAt, from, to int64) (int64, error) {
+	buf := make([]byte, 32*1024)
+	for end := to; end > from; {
+		start := max(from, end-int64(len(buf)))
+		chunk := buf[:end-start]
+		if _, err := f.ReadAt(chunk, start); err != nil && err != io.EOF {
+			return 0, err
+		}
+		if i := bytes.LastIndexByte(chunk, '\n'); i != -1 {
+			return start + int64(i) + 1, nil
+		}
+		end = start
+	}
+	return from, nil
+}
+
+// fileFingerprint returns a hash of the start of the file, up to the given offset
+func fileFingerprint(path string, offset int64) (string, error) {
+	length := min(offset, followFingerprintLength)
+	if length == 0 {
+		return "", nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(f, buf); err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(buf)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// fingerprintMatches returns whether the start of the file still matches the fingerprint in the state
+func fingerprintMatches(path string, state *collection_state.FollowedFileState) bool {
+	if state.Fingerprint == "" {
+		return true
+	}
+	fingerprint, err := fileFingerprint(path, state.Offset)
+	return err == nil && fingerprint == state.Fingerprint
+}
+
+// findFileByInode returns the path and size of the file in the directory with the given inode
+func findFileByInode(dir string, inode uint64) (string, int64) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", 0
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if fileInode(info) == inode {
+			return filepath.Join(dir, entry.Name()), info.Size()
+		}
+	}
+	return "", 0
+}
+
+// findRotatedCopy returns the path and size of the uncompressed rotated copy of the file,
+// e.g. access.log.1 or access.log-20250101 for access.log
+// this is the most recently modified sibling file whose start matches the fingerprint of the followed file
+func findRotatedCopy(path string, state *collection_state.FollowedFileState) (string, int64) {
+	dir, base := filepath.Split(path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", 0
+	}
+
+	var res string
+	var resSize int64
+	var resInfo os.FileInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || !(strings.HasPrefix(name, base+".") || strings.HasPrefix(name, base+"-")) {
+			continue
+		}
+		if slices.Contains(compressedExtensions, filepath.Ext(name)) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Size() < state.Offset {
+			continue
+		}
+		candidate := filepath.Join(dir, name)
+		if !fingerprintMatches(candidate, state) {
+			continue
+		}
+		if resInfo == nil || info.ModTime().After(resInfo.ModTime()) {
+			res, resSize, resInfo = candidate, info.Size(), info
+		}
+	}
+	return res, resSize
+}
@@ -0,0 +1,112 @@
+package artifact_source
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+)
+
+func Test_planFollow(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+
+	write := func(path, content string, flag int) {
+		f, err := os.OpenFile(path, flag|os.O_WRONLY|os.O_CREATE, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.WriteString(content); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	appendTo := func(path, content string) { write(path, content, os.O_APPEND) }
+
+	var state *collection_state.FollowedFileState
+	// collect reads the pending data and updates the state
+	collect := func() string {
+		plan, err := planFollow(path, state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plan == nil {
+			return ""
+		}
+		var buf bytes.Buffer
+		newState, err := plan.copyTo(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state = newState
+		return buf.String()
+	}
+
+	steps := []struct {
+		name   string
+		change func()
+		want   string
+	}{
+		{
+			name:   "initial read",
+			change: func() { appendTo(path, "line 1\nline 2\n") },
+			want:   "line 1\nline 2\n",
+		},
+		{
+			name:   "no change",
+			change: func() {},
+			want:   "",
+		},
+		{
+			name:   "partial line is not read",
+			change: func() { appendTo(path, "line 3\nline 4 partial") },
+			want:   "line 3\n",
+		},
+		{
+			name:   "partial line is completed",
+			change: func() { appendTo(path, " done\n") },
+			want:   "line 4 partial done\n",
+		},
+		{
+			name: "rotation by rename",
+			change: func() {
+				appendTo(path, "line 5\n")
+				if err := os.Rename(path, path+".1"); err != nil {
+					t.Fatal(err)
+				}
+				appendTo(path, "new 1\n")
+			},
+			want: "line 5\nnew 1\n",
+		},
+		{
+			name: "rotation by copytruncate",
+			change: func() {
+				appendTo(path, "new 2\n")
+				content, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				write(path+".1", string(content), os.O_TRUNC)
+				write(path, "", os.O_TRUNC)
+				appendTo(path, "newer 1\n")
+			},
+			want: "new 2\nnewer 1\n",
+		},
+		{
+			name: "truncated and grown past previous offset",
+			change: func() {
+				write(path, "a much longer replacement line\n", os.O_TRUNC)
+			},
+			want: "a much longer replacement line\n",
+		},
+	}
+	for _, step := range steps {
+		step.change()
+		assert.Equal(t, step.want, collect(), step.name)
+	}
+}
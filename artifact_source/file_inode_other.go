@@ -0,0 +1,11 @@
+//go:build !unix
+
+package artifact_source
+
+import "io/fs"
+
+// fileInode returns zero as inodes are not supported on this platform
+// - rotation by rename cannot be detected, but truncation still can
+func fileInode(fs.FileInfo) uint64 {
+	return 0
+}
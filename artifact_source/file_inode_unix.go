@@ -0,0 +1,16 @@
+//go:build unix
+
+package artifact_source
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// fileInode returns the inode of the file
+func fileInode(info fs.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
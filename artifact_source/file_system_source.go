@@ -0,0 +1,306 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/turbot/pipe-fittings/v2/filter"
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/row_source"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// FileSystemSource is an [ArtifactSource] implementation which collects artifacts from the local file system
+//
+// NOTE: the source is not registered automatically - plugins which want to provide it should register it
+// from their package init function:
+//
+//	row_source.RegisterRowSource[*artifact_source.FileSystemSource]()
+type FileSystemSource struct {
+	ArtifactSourceImpl[*FileSystemSourceConfig, *EmptyConnection]
+
+	// in follow mode, the follow plan for each discovered file, keyed by path
+	followPlans sync.Map
+}
+
+func (s *FileSystemSource) Init(ctx context.Context, params *row_source.RowSourceParams, opts ...row_source.RowSourceOption) error {
+	// the collection state depends on whether we are following files
+	// (the config has been parsed by the time the collection state is created)
+	s.NewCollectionStateFunc = func() collection_state.CollectionState[*FileSystemSourceConfig] {
+		if s.Config.GetFollow() {
+			return collection_state.NewFileFollowCollectionState[*FileSystemSourceConfig]()
+		}
+		return collection_state.NewArtifactCollectionStateImpl[*FileSystemSourceConfig]()
+	}
+
+	// call base to parse config and apply options
+	if err := s.ArtifactSourceImpl.Init(ctx, params, opts...); err != nil {
+		return err
+	}
+
+	// enable watch mode if configured
+	s.WatchConfig = s.Config.Watch
+
+	slog.Info("Initialized FileSystemSource", "paths", s.Config.Paths, "layouts", s.Config.GetFileLayouts(), "symlinks", s.Config.GetSymlinkPolicy(), "follow", s.Config.GetFollow())
+	return nil
+}
+
+func (s *FileSystemSource) Identifier() string {
+	return constants.FileSourceIdentifier
+}
+
+func (s *FileSystemSource) Description() (string, error) {
+	return "Collect artifacts from the local file system." + s.describeDownloadLimits(), nil
+}
+
+// DiscoverArtifacts walks each of the configured paths, calling WalkNode for every file and directory
+// in watch mode, it then watches the paths for new files until the context is cancelled
+func (s *FileSystemSource) DiscoverArtifacts(ctx context.Context) error {
+	layouts, g, err := newLayoutMatcher(s.Config.GetFileLayouts(), s.Config.GetPatterns())
+	if err != nil {
+		return err
+	}
+	filterMap := make(map[string]*filter.SqlFilter)
+
+	// keep track of the directories we have visited, to avoid collecting the same directory twice
+	// (or looping forever) when following symlinks
+	visited := make(map[string]struct{})
+
+	// in watch mode, cancelling the context stops the watch, but artifacts which have already been discovered
+	// must still be collected - so do not pass the cancellation on to the discovered artifacts
+	nodeCtx := ctx
+	if s.WatchConfig != nil {
+		nodeCtx = context.WithoutCancel(ctx)
+	}
+	visit := func(targetPath, basePath string, isDir bool) error {
+		if !isDir && s.Config.GetFollow() {
+			// in follow mode, only discover files which have new data
+			hasData, err := s.planFollow(targetPath)
+			if err != nil || !hasData {
+				return err
+			}
+		}
+		return s.WalkNode(nodeCtx, targetPath, basePath, layouts, isDir, g, filterMap)
+	}
+
+	for _, path := range s.Config.Paths {
+		err := walkFileSystemPath(ctx, filepath.Clean(path), s.Config.GetSymlinkPolicy(), visited, visit)
+		if err != nil {
+			return fmt.Errorf("error walking path %s: %w", path, err)
+		}
+	}
+
+	if s.WatchConfig == nil {
+		return nil
+	}
+	return s.WatchPaths(ctx, s.Config.Paths, visit)
+}
+
+// DownloadArtifact does not copy the file - the downloaded artifact just references the original file
+// (in follow mode, the new data is copied to a temp file)
+func (s *FileSystemSource) DownloadArtifact(ctx context.Context, info *types.ArtifactInfo) error {
+	if s.Config.GetFollow() {
+		return s.downloadFollowedFile(ctx, info)
+	}
+
+	stat, err := os.Stat(info.Name)
+	if err != nil {
+		return fmt.Errorf("error getting file info for %s: %w", info.Name, err)
+	}
+
+	downloadInfo := types.NewDownloadedArtifactInfo(info, info.Name, stat.Size())
+	downloadInfo.Version = &types.ArtifactVersion{Size: stat.Size(), ModTime: stat.ModTime()}
+	return s.OnArtifactDownloaded(ctx, downloadInfo)
+}
+
+// GetArtifactVersion implements ArtifactVersioner
+func (s *FileSystemSource) GetArtifactVersion(_ context.Context, name string) (*types.ArtifactVersion, error) {
+	stat, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ArtifactVersion{Size: stat.Size(), ModTime: stat.ModTime()}, nil
+}
+
+// OpenDigestFile implements DigestFileSource
+func (s *FileSystemSource) OpenDigestFile(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+// planFollow determines whether the followed file has new data and if so, stores the follow plan
+// and sets the pending file state in the collection state
+func (s *FileSystemSource) planFollow(path string) (bool, error) {
+	followState, err := s.followCollectionState()
+	if err != nil {
+		return false, err
+	}
+	// if the file has already been discovered and not yet read (e.g. in watch mode), there is nothing to do
+	// - data added since will be read the next time the file changes
+	if _, ok := s.followPlans.Load(path); ok {
+		return false, nil
+	}
+	prev := followState.GetFileState(path)
+	plan, err := planFollow(path, prev)
+	if err != nil {
+		return false, fmt.Errorf("error checking followed file %s: %w", path, err)
+	}
+	if plan == nil {
+		return false, nil
+	}
+	s.followPlans.Store(path, plan)
+	// set the pending state so the collection state knows to collect the file
+	// - this is updated with the new offset once the data has been read
+	followState.SetPendingFileState(path, prev)
+	return true, nil
+}
+
+// downloadFollowedFile copies the new data from the followed file (and any file it was rotated to) into a temp file
+func (s *FileSystemSource) downloadFollowedFile(ctx context.Context, info *types.ArtifactInfo) error {
+	followState, err := s.followCollectionState()
+	if err != nil {
+		return err
+	}
+	p, ok := s.followPlans.LoadAndDelete(info.Name)
+	if !ok {
+		return fmt.Errorf("no follow plan found for %s", info.Name)
+	}
+	plan := p.(*followPlan)
+
+	if err := os.MkdirAll(s.TempDir, 0755); err != nil {
+		return fmt.Errorf("error creating temp directory %s: %w", s.TempDir, err)
+	}
+	// keep the file name as a suffix so the loader is chosen based on the original extension
+	f, err := os.CreateTemp(s.TempDir, "*-"+filepath.Base(info.Name))
+	if err != nil {
+		return fmt.Errorf("error creating temp file for %s: %w", info.Name, err)
+	}
+	state, err := plan.copyTo(f)
+	closeErr := f.Close()
+	if err != nil {
+		return fmt.Errorf("error reading followed file %s: %w", info.Name, err)
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	stat, err := os.Stat(f.Name())
+	if err != nil {
+		return err
+	}
+
+	// update the pending state - this will be committed when the artifact is collected
+	followState.SetPendingFileState(info.Name, state)
+
+	downloadInfo := types.NewDownloadedArtifactInfo(info, f.Name(), stat.Size())
+	return s.OnArtifactDownloaded(ctx, downloadInfo)
+}
+
+func (s *FileSystemSource) followCollectionState() (*collection_state.FileFollowCollectionState[*FileSystemSourceConfig], error) {
+	followState, ok := s.CollectionState.(*collection_state.FileFollowCollectionState[*FileSystemSourceConfig])
+	if !ok {
+		return nil, fmt.Errorf("follow mode requires a FileFollowCollectionState, got %T", s.CollectionState)
+	}
+	return followState, nil
+}
+
+// walkFileSystemPath walks the given path, calling visit for each file and directory below it
+// - if the path is a file, visit is called for the file only, with the parent directory as the base path
+// - if visit returns fs.SkipDir for a directory, the directory is not descended into
+// - symlinks are handled according to the given symlink policy
+func walkFileSystemPath(ctx context.Context, path string, symlinkPolicy string, visited map[string]struct{}, visit func(targetPath, basePath string, isDir bool) error) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !stat.IsDir() {
+		return visit(path, filepath.Dir(path), false)
+	}
+
+	// mark the base path as visited
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := visited[realPath]; ok {
+		return nil
+	}
+	visited[realPath] = struct{}{}
+
+	return walkFileSystemDir(ctx, path, path, symlinkPolicy, visited, visit)
+}
+
+func walkFileSystemDir(ctx context.Context, dir, basePath string, symlinkPolicy string, visited map[string]struct{}, visit func(targetPath, basePath string, isDir bool) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		targetPath := filepath.Join(dir, entry.Name())
+		isDir := entry.IsDir()
+
+		switch {
+		case entry.Type()&fs.ModeSymlink != 0:
+			if symlinkPolicy == SymlinkPolicyIgnore {
+				continue
+			}
+			// resolve the link target
+			targetStat, err := os.Stat(targetPath)
+			if err != nil {
+				slog.Warn("Skipping unresolvable symlink", "path", targetPath, "error", err)
+				continue
+			}
+			isDir = targetStat.IsDir()
+			if isDir && symlinkPolicy != SymlinkPolicyFollow {
+				continue
+			}
+			if !isDir && !targetStat.Mode().IsRegular() {
+				continue
+			}
+		case !isDir && !entry.Type().IsRegular():
+			// skip devices, sockets, pipes etc.
+			continue
+		}
+
+		err := visit(targetPath, basePath, isDir)
+		if !isDir {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if errors.Is(err, fs.SkipDir) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// do not descend into a directory we have already visited
+		realPath, err := filepath.EvalSymlinks(targetPath)
+		if err != nil {
+			return err
+		}
+		if _, ok := visited[realPath]; ok {
+			continue
+		}
+		visited[realPath] = struct{}{}
+
+		if err := walkFileSystemDir(ctx, targetPath, basePath, symlinkPolicy, visited, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
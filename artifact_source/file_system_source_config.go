@@ -0,0 +1,86 @@
+package artifact_source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+)
+
+// symlink policies supported by the FileSystemSource
+const (
+	// SymlinkPolicyIgnore skips all symlinks
+	SymlinkPolicyIgnore = "ignore"
+	// SymlinkPolicyFiles follows symlinks to files, but does not descend into symlinked directories
+	SymlinkPolicyFiles = "files"
+	// SymlinkPolicyFollow follows symlinks to both files and directories
+	SymlinkPolicyFollow = "follow"
+)
+
+// FileSystemSourceConfig is the config for the FileSystemSource
+type FileSystemSourceConfig struct {
+	artifact_source_config.ArtifactSourceConfigImpl
+	// required to allow partial decoding
+	Remain hcl.Body `hcl:",remain" json:"-"`
+
+	// the paths to collect from - each may be a directory or a single file
+	Paths []string `hcl:"paths"`
+	// how symlinks are handled: ignore, files (the default) or follow
+	Symlinks *string `hcl:"symlinks,optional"`
+	// if set, files are followed as they grow - only data appended since the last collection is read,
+	// and rotation (by rename or copytruncate) is detected
+	Follow *bool `hcl:"follow,optional"`
+	// if set, after the initial discovery the source watches the paths for new files until the collection is cancelled
+	Watch *artifact_source_config.WatchConfig `hcl:"watch,block"`
+}
+
+func (c *FileSystemSourceConfig) Validate() error {
+	if len(c.Paths) == 0 {
+		return fmt.Errorf("paths is required and cannot be empty")
+	}
+	for _, p := range c.Paths {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("paths cannot contain an empty path")
+		}
+	}
+	if c.Symlinks != nil {
+		switch *c.Symlinks {
+		case SymlinkPolicyIgnore, SymlinkPolicyFiles, SymlinkPolicyFollow:
+		default:
+			return fmt.Errorf("invalid symlinks value '%s' - must be one of %s, %s, %s", *c.Symlinks, SymlinkPolicyIgnore, SymlinkPolicyFiles, SymlinkPolicyFollow)
+		}
+	}
+	if c.Watch != nil {
+		if err := c.Watch.Validate(); err != nil {
+			return fmt.Errorf("invalid watch config: %w", err)
+		}
+	}
+	// followed files are read incrementally, so cannot be verified against a digest of the whole file
+	if c.Checksum != nil && c.GetFollow() {
+		return fmt.Errorf("checksum cannot be used with follow")
+	}
+	// followed files are always read from where collection stopped, so changes are handled already
+	if c.OnChange != nil && c.GetFollow() {
+		return fmt.Errorf("on_change cannot be used with follow")
+	}
+	return c.ArtifactSourceConfigImpl.Validate()
+}
+
+func (c *FileSystemSourceConfig) Identifier() string {
+	return constants.FileSourceIdentifier
+}
+
+// GetSymlinkPolicy returns the configured symlink policy, defaulting to SymlinkPolicyFiles
+func (c *FileSystemSourceConfig) GetSymlinkPolicy() string {
+	if c.Symlinks == nil {
+		return SymlinkPolicyFiles
+	}
+	return *c.Symlinks
+}
+
+// GetFollow returns whether files should be followed as they grow
+func (c *FileSystemSourceConfig) GetFollow() bool {
+	return c.Follow != nil && *c.Follow
+}
@@ -0,0 +1,122 @@
+package artifact_source
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func Test_walkFileSystemPath(t *testing.T) {
+	// build a tree:
+	// root/a/1.log
+	// root/a/b/2.log
+	// root/skip/3.log
+	// root/file_link -> root/a/1.log
+	// root/dir_link -> other
+	// root/loop -> root
+	// other/4.log
+	tmp := t.TempDir()
+	root := filepath.Join(tmp, "root")
+	other := filepath.Join(tmp, "other")
+	for _, dir := range []string{filepath.Join(root, "a", "b"), filepath.Join(root, "skip"), other} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range []string{"a/1.log", "a/b/2.log", "skip/3.log", "../other/4.log"} {
+		if err := os.WriteFile(filepath.Join(root, f), []byte("data"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		"file_link": filepath.Join(root, "a", "1.log"),
+		"dir_link":  other,
+		"loop":      root,
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(root, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		symlinkPolicy string
+		want          []string
+	}{
+		{
+			name:          "ignore symlinks",
+			path:          root,
+			symlinkPolicy: SymlinkPolicyIgnore,
+			want:          []string{"a/", "a/1.log", "a/b/", "a/b/2.log", "skip/"},
+		},
+		{
+			name:          "follow file symlinks",
+			path:          root,
+			symlinkPolicy: SymlinkPolicyFiles,
+			want:          []string{"a/", "a/1.log", "a/b/", "a/b/2.log", "file_link", "skip/"},
+		},
+		{
+			name:          "follow all symlinks",
+			path:          root,
+			symlinkPolicy: SymlinkPolicyFollow,
+			want:          []string{"a/", "a/1.log", "a/b/", "a/b/2.log", "dir_link/", "dir_link/4.log", "file_link", "loop/", "skip/"},
+		},
+		{
+			name:          "single file",
+			path:          filepath.Join(root, "a", "1.log"),
+			symlinkPolicy: SymlinkPolicyFiles,
+			want:          []string{"1.log"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := walkFileSystemPath(context.Background(), tt.path, tt.symlinkPolicy, make(map[string]struct{}), func(targetPath, basePath string, isDir bool) error {
+				rel, err := filepath.Rel(basePath, targetPath)
+				if err != nil {
+					return err
+				}
+				if isDir {
+					got = append(got, rel+"/")
+					// skip the 'skip' directory
+					if rel == "skip" {
+						return fs.SkipDir
+					}
+					return nil
+				}
+				got = append(got, rel)
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFileSystemSourceConfig_parse(t *testing.T) {
+	configData := types.NewSourceConfigData([]byte(`
+paths       = ["/var/log/app"]
+file_layout = "%%{DATA}.log"
+on_change   = "recollect"
+`), hcl.Range{Filename: "test.hcl"}, constants.FileSourceIdentifier)
+
+	c, err := parse.ParseConfig[*FileSystemSourceConfig](configData)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, []string{"/var/log/app"}, c.Paths)
+	assert.Equal(t, []string{"%{DATA}.log"}, c.GetFileLayouts())
+	assert.Equal(t, artifact_source_config.ChangePolicyRecollect, c.GetChangePolicy())
+}
@@ -42,6 +42,33 @@ func dirSatisfiesFromTime(fromTime time.Time, metadata map[string]string) bool {
 	return timestamp.Compare(truncatedFromTime) >= 0
 }
 
+// dirSatisfiesToTime returns whether a directory may contain artifacts before the to time,
+// i.e. whether the start of the period given by its time metadata is not after the to time
+func dirSatisfiesToTime(toTime time.Time, metadata map[string]string) bool {
+	// any time units which are not in the metadata take their minimum value
+	valueLookup := newTimeMap()
+
+	expectedKeys := []string{constants.TemplateFieldYear, constants.TemplateFieldMonth, constants.TemplateFieldDay, constants.TemplateFieldHour, constants.TemplateFieldMinute, constants.TemplateFieldSecond}
+
+	for _, key := range expectedKeys {
+		valString, ok := metadata[key]
+		if !ok {
+			// if we don't even have a year, this folder does not have date information so it satisfies the to time
+			if key == constants.TemplateFieldYear {
+				return true
+			}
+			break
+		}
+		val, err := strconv.Atoi(valString)
+		if err != nil {
+			return false
+		}
+		valueLookup[key] = val
+	}
+
+	return timeFromMap(valueLookup).Compare(toTime) <= 0
+}
+
 type timeMap map[string]int
 
 func newTimeMap() timeMap {
@@ -336,3 +336,61 @@ func Test_dirSatisfiesFromTime(t *testing.T) {
 		})
 	}
 }
+
+func Test_dirSatisfiesToTime(t *testing.T) {
+	toTime := time.Date(2025, 6, 6, 6, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		want     bool
+	}{
+		{
+			name:     "No year, should be included",
+			metadata: map[string]string{},
+			want:     true,
+		},
+		{
+			name:     "Year only, same year, should be included",
+			metadata: map[string]string{"year": "2025"},
+			want:     true,
+		},
+		{
+			name:     "Year only, next year, should be excluded",
+			metadata: map[string]string{"year": "2026"},
+			want:     false,
+		},
+		{
+			name:     "Year and month, same month, should be included",
+			metadata: map[string]string{"year": "2025", "month": "06"},
+			want:     true,
+		},
+		{
+			name:     "Year and month, next month, should be excluded",
+			metadata: map[string]string{"year": "2025", "month": "07"},
+			want:     false,
+		},
+		{
+			name:     "Year, month, day, previous day, should be included",
+			metadata: map[string]string{"year": "2025", "month": "06", "day": "05"},
+			want:     true,
+		},
+		{
+			name:     "Year, month, day and hour, later hour, should be excluded",
+			metadata: map[string]string{"year": "2025", "month": "06", "day": "06", "hour": "07"},
+			want:     false,
+		},
+		{
+			name:     "Invalid year, should be excluded",
+			metadata: map[string]string{"year": "abc"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dirSatisfiesToTime(toTime, tt.metadata)
+			if got != tt.want {
+				t.Errorf("dirSatisfiesToTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
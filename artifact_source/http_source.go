@@ -0,0 +1,632 @@
+package artifact_source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/pipe-fittings/v2/filter"
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/row_source"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+	"golang.org/x/net/html"
+)
+
+// HttpSource is an [ArtifactSource] implementation which collects artifacts over HTTP(S)
+//
+// Artifacts are discovered from index pages (e.g. web server directory listings), a JSON manifest
+// and/or URL templates containing time placeholders. The file layout is applied to the URL path,
+// relative to the index URL (for index pages) or the host (for manifest and template URLs).
+//
+// The ETag and Last-Modified headers of each artifact are stored in the collection state, and conditional requests
+// are used to determine whether previously collected artifacts have changed.
+//
+// NOTE: the source is not registered automatically - plugins which want to provide it should register it
+// from their package init function:
+//
+//	row_source.RegisterRowSource[*artifact_source.HttpSource]()
+type HttpSource struct {
+	ArtifactSourceImpl[*HttpSourceConfig, *EmptyConnection]
+
+	client *http.Client
+	// map of artifact name to the URL to download it from, if different (i.e. if the URL has a query string)
+	downloadUrls sync.Map
+	// map of artifact name to the validator (ETag or Last-Modified) used to resume a partial download
+	resumeValidators sync.Map
+	// the names of artifacts generated from URL templates, which must be checked to exist before they are collected
+	unconfirmedUrls sync.Map
+}
+
+func (s *HttpSource) Init(ctx context.Context, params *row_source.RowSourceParams, opts ...row_source.RowSourceOption) error {
+	s.NewCollectionStateFunc = collection_state.NewHttpCollectionState[*HttpSourceConfig]
+
+	// call base to parse config and apply options
+	if err := s.ArtifactSourceImpl.Init(ctx, params, opts...); err != nil {
+		return err
+	}
+	s.client = &http.Client{}
+
+	slog.Info("Initialized HttpSource", "index urls", s.Config.IndexUrls, "urls", s.Config.Urls, "layouts", s.Config.GetFileLayouts())
+	return nil
+}
+
+func (s *HttpSource) Identifier() string {
+	return constants.HttpSourceIdentifier
+}
+
+func (s *HttpSource) Description() (string, error) {
+	return "Collect artifacts over HTTP(S) from index pages, JSON manifests or URL templates." + s.describeDownloadLimits(), nil
+}
+
+// DiscoverArtifacts discovers artifacts from the configured index pages, manifest and URL templates
+func (s *HttpSource) DiscoverArtifacts(ctx context.Context) error {
+	layouts, g, err := newLayoutMatcher(s.Config.GetFileLayouts(), s.Config.GetPatterns())
+	if err != nil {
+		return err
+	}
+	filterMap := make(map[string]*filter.SqlFilter)
+
+	// visit is called for each discovered URL - artifacts are checked (see CheckArtifact) once they have matched
+	// the file layout, filters and collection time range
+	visit := func(targetPath, basePath string, isDir bool) error {
+		return s.WalkNode(ctx, targetPath, basePath, layouts, isDir, g, filterMap)
+	}
+
+	for _, indexUrl := range s.Config.IndexUrls {
+		err := walkHttpIndex(ctx, s.get, indexUrl, visit)
+		if err != nil {
+			return fmt.Errorf("error walking index %s: %w", indexUrl, err)
+		}
+	}
+
+	if s.Config.Manifest != nil {
+		manifestUrls, err := getHttpManifestUrls(ctx, s.get, s.Config.Manifest)
+		if err != nil {
+			return fmt.Errorf("error reading manifest %s: %w", s.Config.Manifest.Url, err)
+		}
+		for _, u := range manifestUrls {
+			if err := s.visitUrl(u, visit, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	// expand templates up to the to time, if set
+	to := s.ToTime
+	if to.IsZero() {
+		to = time.Now()
+	}
+	for _, template := range s.Config.Urls {
+		for _, u := range expandHttpUrlTemplate(template, s.FromTime, to) {
+			if err := s.visitUrl(u, visit, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// visitUrl visits an artifact URL, using the URL of the host as the base path
+// any query string is removed from the artifact name, and the full URL is stored for download
+// if checkExists is set (i.e. for URLs generated from templates), the artifact is checked to exist before it is collected
+func (s *HttpSource) visitUrl(rawUrl string, visit func(targetPath, basePath string, isDir bool) error, checkExists bool) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return fmt.Errorf("invalid artifact url '%s': %w", rawUrl, err)
+	}
+	name := artifactUrlName(u)
+	if name != rawUrl {
+		s.downloadUrls.Store(name, rawUrl)
+	}
+	if checkExists {
+		s.unconfirmedUrls.Store(name, struct{}{})
+	}
+	basePath := (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	return visit(name, basePath, false)
+}
+
+// CheckArtifact implements ArtifactChecker - it determines whether the artifact should be collected:
+// - new artifacts are collected (if the URL was generated from a template, a HEAD request is made to check the
+// artifact exists)
+// - previously collected artifacts are collected again only if a conditional request shows they have changed
+// if the artifact should be collected, its pending state is set in the collection state, otherwise any pending
+// state is cleared
+func (s *HttpSource) CheckArtifact(ctx context.Context, info *types.ArtifactInfo) (bool, error) {
+	state, err := s.httpCollectionState()
+	if err != nil {
+		return false, err
+	}
+	_, checkExists := s.unconfirmedUrls.Load(info.Name)
+	prev := state.GetObjectState(info.Name)
+
+	collect, err := s.isNewOrChanged(ctx, info.Name, prev, checkExists)
+	if err != nil || !collect {
+		state.ClearPendingObjectState(info.Name)
+		return false, err
+	}
+
+	// set the pending state so the collection state knows to collect the artifact
+	// - this is updated with the validators returned when the artifact is downloaded
+	state.SetPendingObjectState(info.Name, prev)
+	return true, nil
+}
+
+// isNewOrChanged returns whether the artifact is new, or has changed since it was collected
+func (s *HttpSource) isNewOrChanged(ctx context.Context, name string, prev *collection_state.HttpObjectState, checkExists bool) (bool, error) {
+	switch {
+	case prev == nil && !checkExists:
+		// new artifact
+		return true, nil
+	case prev != nil && (!s.Config.GetDetectChanges() || (prev.ETag == "" && prev.LastModified == "")):
+		// already collected, and we cannot (or should not) check whether it has changed
+		return false, nil
+	default:
+		return s.head(ctx, name, prev)
+	}
+}
+
+// head makes a (conditional, if we have a previous state) HEAD request for the artifact
+// and returns whether it exists and has changed
+func (s *HttpSource) head(ctx context.Context, name string, prev *collection_state.HttpObjectState) (bool, error) {
+	req, err := s.newRequest(ctx, http.MethodHead, s.downloadUrl(name))
+	if err != nil {
+		return false, err
+	}
+	if prev != nil {
+		if prev.ETag != "" {
+			req.Header.Set("If-None-Match", prev.ETag)
+		}
+		if prev.LastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.LastModified)
+		}
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("error requesting %s: %w", name, err)
+	}
+	_ = resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return false, nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		slog.Debug("Artifact does not exist", "url", name)
+		return false, nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		// if there is no previous state, the artifact exists
+		// otherwise the server has ignored the conditional headers - check the validators ourselves
+		return prev == nil || resp.Header.Get("ETag") != prev.ETag || resp.Header.Get("Last-Modified") != prev.LastModified, nil
+	case resp.StatusCode == http.StatusMethodNotAllowed:
+		// the server does not support HEAD - collect new artifacts, but we cannot detect changes
+		return prev == nil, nil
+	default:
+		return false, fmt.Errorf("error requesting %s: %s", name, resp.Status)
+	}
+}
+
+// DownloadArtifact downloads the artifact to the temp directory
+func (s *HttpSource) DownloadArtifact(ctx context.Context, info *types.ArtifactInfo) (err error) {
+	defer s.clearPendingStateOnError(info.Name, &err)
+
+	resp, err := s.get(ctx, s.downloadUrl(info.Name))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := os.MkdirAll(s.TempDir, 0755); err != nil {
+		return fmt.Errorf("error creating temp directory %s: %w", s.TempDir, err)
+	}
+	// keep the file name as a suffix so the loader is chosen based on the original extension
+	f, err := os.CreateTemp(s.TempDir, "*-"+path.Base(info.Name))
+	if err != nil {
+		return fmt.Errorf("error creating temp file for %s: %w", info.Name, err)
+	}
+	return s.writeArtifact(ctx, info, resp, f, 0)
+}
+
+// ResumeArtifactDownload requests the remainder of a partially downloaded artifact using a range request
+// the If-Range header ensures the server returns the whole artifact if it has changed since the download started
+func (s *HttpSource) ResumeArtifactDownload(ctx context.Context, info *types.ArtifactInfo, partial *PartialDownloadError) (err error) {
+	defer s.clearPendingStateOnError(info.Name, &err)
+
+	validator, ok := s.resumeValidators.LoadAndDelete(info.Name)
+	if !ok {
+		// without a validator we cannot tell if the artifact has changed - start again
+		_ = os.Remove(partial.LocalPath)
+		return s.DownloadArtifact(ctx, info)
+	}
+
+	rawUrl := s.downloadUrl(info.Name)
+	req, err := s.newRequest(ctx, http.MethodGet, rawUrl)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-", partial.Offset))
+	req.Header.Set("If-Range", validator.(string))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// keep the validator so the next attempt can resume
+		s.resumeValidators.Store(info.Name, validator)
+		return &PartialDownloadError{LocalPath: partial.LocalPath, Offset: partial.Offset, Err: fmt.Errorf("error requesting %s: %w", rawUrl, err)}
+	}
+	defer resp.Body.Close()
+
+	var offset int64
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// the server has returned the rest of the artifact
+		offset = partial.Offset
+	case http.StatusOK:
+		// the artifact has changed (or the server does not support ranges) - the whole artifact has been returned
+		slog.Debug("Server returned the whole artifact - restarting download", "url", rawUrl)
+	default:
+		_ = os.Remove(partial.LocalPath)
+		return &httpStatusError{Url: rawUrl, StatusCode: resp.StatusCode, Status: resp.Status, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+
+	f, err := os.OpenFile(partial.LocalPath, os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(offset); err == nil {
+		_, err = f.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(partial.LocalPath)
+		return err
+	}
+	return s.writeArtifact(ctx, info, resp, f, offset)
+}
+
+// writeArtifact writes the response body to the local file (which already contains offset bytes of the artifact),
+// then updates the pending collection state and notifies that the artifact has been downloaded
+func (s *HttpSource) writeArtifact(ctx context.Context, info *types.ArtifactInfo, resp *http.Response, f *os.File, offset int64) error {
+	state, err := s.httpCollectionState()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	size, err := copyArtifactData(f, s.limitBandwidth(ctx, resp.Body), offset)
+	if err != nil {
+		var partialErr *PartialDownloadError
+		if errors.As(err, &partialErr) {
+			// store the validator used to check the artifact has not changed when resuming
+			// only strong ETags may be used with If-Range
+			if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				s.resumeValidators.Store(info.Name, etag)
+			} else if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+				s.resumeValidators.Store(info.Name, lastModified)
+			}
+		}
+		return fmt.Errorf("error downloading %s: %w", info.Name, err)
+	}
+
+	// update the pending state - this will be committed when the artifact is collected
+	state.SetPendingObjectState(info.Name, &collection_state.HttpObjectState{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	})
+
+	downloadInfo := types.NewDownloadedArtifactInfo(info, f.Name(), size)
+	return s.OnArtifactDownloaded(ctx, downloadInfo)
+}
+
+// clearPendingStateOnError clears the pending state of the artifact if the download failed, so it is not left in the
+// collection state if the artifact is not collected (if a retried download succeeds, the pending state is set again)
+func (s *HttpSource) clearPendingStateOnError(name string, err *error) {
+	if *err == nil {
+		return
+	}
+	if state, stateErr := s.httpCollectionState(); stateErr == nil {
+		state.ClearPendingObjectState(name)
+	}
+}
+
+// OpenDigestFile implements DigestFileSource
+func (s *HttpSource) OpenDigestFile(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := s.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// IsRetryableError implements RetryableErrorClassifier
+// in addition to the default classification, request timeouts, rate limiting and server errors are retryable
+func (s *HttpSource) IsRetryableError(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusRequestTimeout ||
+			statusErr.StatusCode == http.StatusTooManyRequests ||
+			statusErr.StatusCode >= 500
+	}
+	return IsRetryableError(err)
+}
+
+// IsThrottlingError implements ThrottlingErrorClassifier
+// rate limiting (429) and service unavailable (503) responses indicate throttling
+func (s *HttpSource) IsThrottlingError(err error) (bool, time.Duration) {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode == http.StatusServiceUnavailable) {
+		return true, statusErr.RetryAfter
+	}
+	return false, 0
+}
+
+// get makes a GET request, returning an httpStatusError if the response status is not 200
+func (s *HttpSource) get(ctx context.Context, rawUrl string) (*http.Response, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting %s: %w", rawUrl, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, &httpStatusError{Url: rawUrl, StatusCode: resp.StatusCode, Status: resp.Status, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+	return resp, nil
+}
+
+func (s *HttpSource) newRequest(ctx context.Context, method, rawUrl string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range s.Config.Headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
+
+func (s *HttpSource) downloadUrl(name string) string {
+	if u, ok := s.downloadUrls.Load(name); ok {
+		return u.(string)
+	}
+	return name
+}
+
+func (s *HttpSource) httpCollectionState() (*collection_state.HttpCollectionState[*HttpSourceConfig], error) {
+	state, ok := s.CollectionState.(*collection_state.HttpCollectionState[*HttpSourceConfig])
+	if !ok {
+		return nil, fmt.Errorf("HttpSource requires an HttpCollectionState, got %T", s.CollectionState)
+	}
+	return state, nil
+}
+
+// httpStatusError is returned when a request receives an unexpected response status
+type httpStatusError struct {
+	Url        string
+	StatusCode int
+	Status     string
+	// the delay requested by the Retry-After header (if any)
+	RetryAfter time.Duration
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("error requesting %s: %s", e.Url, e.Status)
+}
+
+// artifactUrlName returns the URL without any query string or fragment - this is used as the artifact name
+func artifactUrlName(u *url.URL) string {
+	res := *u
+	res.RawQuery = ""
+	res.Fragment = ""
+	return res.String()
+}
+
+// walkHttpIndex walks the index page at the given URL, calling visit for each link below the index URL
+// - links ending in '/' are treated as directories and are walked in turn unless visit returns fs.SkipDir
+// - the index URL (without any trailing '/') is used as the base path
+func walkHttpIndex(ctx context.Context, get func(context.Context, string) (*http.Response, error), indexUrl string, visit func(targetPath, basePath string, isDir bool) error) error {
+	base, err := url.Parse(indexUrl)
+	if err != nil {
+		return err
+	}
+	base.RawQuery = ""
+	base.Fragment = ""
+	// the index is a directory - ensure relative links are resolved against it
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	basePath := strings.TrimSuffix(base.String(), "/")
+
+	visited := make(map[string]struct{})
+	var walk func(dir *url.URL) error
+	walk = func(dir *url.URL) error {
+		links, err := getHttpIndexLinks(ctx, get, dir.String())
+		if err != nil {
+			return err
+		}
+		for _, link := range links {
+			u, err := dir.Parse(link)
+			if err != nil {
+				slog.Debug("Ignoring invalid link", "link", link, "error", err)
+				continue
+			}
+			u.RawQuery = ""
+			u.Fragment = ""
+			// only follow links below this directory (this excludes parent directory and sort links)
+			if u.Host != dir.Host || !strings.HasPrefix(u.Path, dir.Path) || u.Path == dir.Path {
+				continue
+			}
+			if _, ok := visited[u.String()]; ok {
+				continue
+			}
+			visited[u.String()] = struct{}{}
+
+			isDir := strings.HasSuffix(u.Path, "/")
+			err = visit(strings.TrimSuffix(u.String(), "/"), basePath, isDir)
+			if !isDir {
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, fs.SkipDir) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := walk(u); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(base)
+}
+
+// getHttpIndexLinks returns the href of every link in the page
+func getHttpIndexLinks(ctx context.Context, get func(context.Context, string) (*http.Response, error), pageUrl string) ([]string, error) {
+	resp, err := get(ctx, pageUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var links []string
+	tokenizer := html.NewTokenizer(resp.Body)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("error parsing index page %s: %w", pageUrl, err)
+			}
+			return links, nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data != "a" {
+				continue
+			}
+			for _, attr := range token.Attr {
+				if attr.Key == "href" && attr.Val != "" {
+					links = append(links, attr.Val)
+				}
+			}
+		}
+	}
+}
+
+// getHttpManifestUrls reads the JSON manifest and returns the artifact URLs it contains
+// relative URLs are resolved against the manifest URL
+func getHttpManifestUrls(ctx context.Context, get func(context.Context, string) (*http.Response, error), config *HttpManifestConfig) ([]string, error) {
+	resp, err := get(ctx, config.Url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var data any
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("error parsing manifest: %w", err)
+	}
+
+	// navigate to the items
+	if config.ItemsPath != nil {
+		for _, key := range strings.Split(*config.ItemsPath, ".") {
+			obj, ok := data.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("items_path '%s' not found in manifest", *config.ItemsPath)
+			}
+			data = obj[key]
+		}
+	}
+	items, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("manifest items must be an array")
+	}
+
+	manifestUrl, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, err
+	}
+	urlField := config.GetUrlField()
+	var res []string
+	for i, item := range items {
+		var itemUrl string
+		switch v := item.(type) {
+		case string:
+			itemUrl = v
+		case map[string]any:
+			itemUrl, _ = v[urlField].(string)
+		}
+		if itemUrl == "" {
+			return nil, fmt.Errorf("manifest item %d has no url", i)
+		}
+		u, err := manifestUrl.Parse(itemUrl)
+		if err != nil {
+			return nil, fmt.Errorf("manifest item %d has an invalid url '%s': %w", i, itemUrl, err)
+		}
+		res = append(res, u.String())
+	}
+	return res, nil
+}
+
+// expandHttpUrlTemplate returns the URLs generated by the template for each period between from and to
+// the period is the smallest time placeholder in the template - if there are no placeholders, the template is
+// returned as is
+func expandHttpUrlTemplate(template string, from, to time.Time) []string {
+	placeholder := func(field string) string {
+		return "{" + field + "}"
+	}
+	// determine the period, from the smallest placeholder
+	var truncate func(time.Time) time.Time
+	var next func(time.Time) time.Time
+	switch {
+	case strings.Contains(template, placeholder(constants.TemplateFieldMinute)):
+		truncate = func(t time.Time) time.Time { return t.Truncate(time.Minute) }
+		next = func(t time.Time) time.Time { return t.Add(time.Minute) }
+	case strings.Contains(template, placeholder(constants.TemplateFieldHour)):
+		truncate = func(t time.Time) time.Time { return t.Truncate(time.Hour) }
+		next = func(t time.Time) time.Time { return t.Add(time.Hour) }
+	case strings.Contains(template, placeholder(constants.TemplateFieldDay)):
+		truncate = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC) }
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case strings.Contains(template, placeholder(constants.TemplateFieldMonth)):
+		truncate = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC) }
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case strings.Contains(template, placeholder(constants.TemplateFieldYear)):
+		truncate = func(t time.Time) time.Time { return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC) }
+		next = func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+	default:
+		return []string{template}
+	}
+
+	// if there is no from time, just generate the URL for the current period
+	if from.IsZero() {
+		from = to
+	}
+	var res []string
+	for t := truncate(from.UTC()); !t.After(to); t = next(t) {
+		r := strings.NewReplacer(
+			placeholder(constants.TemplateFieldYear), fmt.Sprintf("%04d", t.Year()),
+			placeholder(constants.TemplateFieldMonth), fmt.Sprintf("%02d", t.Month()),
+			placeholder(constants.TemplateFieldDay), fmt.Sprintf("%02d", t.Day()),
+			placeholder(constants.TemplateFieldHour), fmt.Sprintf("%02d", t.Hour()),
+			placeholder(constants.TemplateFieldMinute), fmt.Sprintf("%02d", t.Minute()),
+		)
+		res = append(res, r.Replace(template))
+	}
+	return res
+}
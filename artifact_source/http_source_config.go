@@ -0,0 +1,105 @@
+package artifact_source
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+)
+
+const (
+	defaultHttpManifestUrlField = "url"
+)
+
+// HttpSourceConfig is the config for the HttpSource
+// artifacts may be discovered from any combination of index pages, a JSON manifest and URL templates
+type HttpSourceConfig struct {
+	artifact_source_config.ArtifactSourceConfigImpl
+	// required to allow partial decoding
+	Remain hcl.Body `hcl:",remain" json:"-"`
+
+	// index pages (e.g. web server directory listings) - links on the page are followed,
+	// with links ending in '/' treated as directories
+	IndexUrls []string `hcl:"index_urls,optional"`
+	// a JSON manifest listing the artifact URLs
+	Manifest *HttpManifestConfig `hcl:"manifest,block"`
+	// URL templates which may contain time placeholders: {year}, {month}, {day}, {hour}, {minute}
+	// a URL is generated for each period from the from time until now
+	Urls []string `hcl:"urls,optional"`
+
+	// headers to add to every request, e.g. Authorization
+	Headers map[string]string `hcl:"headers,optional"`
+	// whether to make conditional requests for previously collected artifacts and collect them again if they
+	// have changed (defaults to true)
+	DetectChanges *bool `hcl:"detect_changes,optional"`
+}
+
+func (c *HttpSourceConfig) Validate() error {
+	if len(c.IndexUrls) == 0 && c.Manifest == nil && len(c.Urls) == 0 {
+		return fmt.Errorf("at least one of index_urls, manifest or urls must be set")
+	}
+	for _, u := range c.IndexUrls {
+		if err := validateHttpUrl(u); err != nil {
+			return fmt.Errorf("invalid index url: %w", err)
+		}
+	}
+	for _, u := range c.Urls {
+		if err := validateHttpUrl(u); err != nil {
+			return fmt.Errorf("invalid url: %w", err)
+		}
+	}
+	if c.Manifest != nil {
+		if err := c.Manifest.Validate(); err != nil {
+			return fmt.Errorf("invalid manifest: %w", err)
+		}
+	}
+	return c.ArtifactSourceConfigImpl.Validate()
+}
+
+func (c *HttpSourceConfig) Identifier() string {
+	return constants.HttpSourceIdentifier
+}
+
+// GetDetectChanges returns whether previously collected artifacts should be checked for changes
+func (c *HttpSourceConfig) GetDetectChanges() bool {
+	return c.DetectChanges == nil || *c.DetectChanges
+}
+
+// HttpManifestConfig defines a JSON manifest containing artifact URLs
+type HttpManifestConfig struct {
+	// the URL of the manifest
+	Url string `hcl:"url"`
+	// dot separated path to the array of items within the manifest, e.g. "data.files"
+	// if not set, the manifest must be an array
+	ItemsPath *string `hcl:"items_path,optional"`
+	// the field of each item containing the artifact URL (defaults to "url")
+	// if the items are strings, they are used as the URL directly
+	UrlField *string `hcl:"url_field,optional"`
+}
+
+func (c *HttpManifestConfig) Validate() error {
+	return validateHttpUrl(c.Url)
+}
+
+func (c *HttpManifestConfig) GetUrlField() string {
+	if c.UrlField == nil {
+		return defaultHttpManifestUrlField
+	}
+	return *c.UrlField
+}
+
+func validateHttpUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("'%s' must be an http or https url", rawUrl)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("'%s' has no host", rawUrl)
+	}
+	return nil
+}
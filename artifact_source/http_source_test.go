@@ -0,0 +1,285 @@
+package artifact_source
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/context_values"
+	"github.com/turbot/tailpipe-plugin-sdk/events"
+	"github.com/turbot/tailpipe-plugin-sdk/row_source"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func newTestHttpSource(client *http.Client) *HttpSource {
+	s := &HttpSource{client: client}
+	s.Config = &HttpSourceConfig{}
+	return s
+}
+
+func Test_walkHttpIndex(t *testing.T) {
+	pages := map[string]string{
+		"/logs/":         `<a href="../">Parent</a><a href="?C=N;O=D">Name</a><a href="2024/">2024/</a><a href="skip/">skip/</a><a href="root.log">root.log</a>`,
+		"/logs/2024/":    `<a href="/logs/">Parent</a><a href="01/">01/</a><a href="https://other.example.com/x.log">x</a>`,
+		"/logs/2024/01/": `<a href="a.log.gz">a.log.gz</a><a href="/logs/2024/01/b.log.gz">b.log.gz</a>`,
+		"/logs/skip/":    `<a href="hidden.log">hidden.log</a>`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = fmt.Fprint(w, page)
+	}))
+	defer srv.Close()
+
+	s := newTestHttpSource(srv.Client())
+	var got []string
+	err := walkHttpIndex(context.Background(), s.get, srv.URL+"/logs", func(targetPath, basePath string, isDir bool) error {
+		assert.Equal(t, srv.URL+"/logs", basePath)
+		rel := targetPath[len(basePath)+1:]
+		if isDir {
+			got = append(got, rel+"/")
+			if rel == "skip" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		got = append(got, rel)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024/", "2024/01/", "2024/01/a.log.gz", "2024/01/b.log.gz", "skip/", "root.log"}, got)
+}
+
+func Test_getHttpManifestUrls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/objects.json":
+			_, _ = fmt.Fprint(w, `{"data":{"files":[{"location":"logs/a.json"},{"location":"https://cdn.example.com/b.json?sig=1"}]}}`)
+		case "/strings.json":
+			_, _ = fmt.Fprint(w, `["/logs/c.json"]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	s := newTestHttpSource(srv.Client())
+
+	tests := []struct {
+		name    string
+		config  *HttpManifestConfig
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "objects with items path",
+			config: &HttpManifestConfig{Url: srv.URL + "/objects.json", ItemsPath: utils.ToPointer("data.files"), UrlField: utils.ToPointer("location")},
+			want:   []string{srv.URL + "/logs/a.json", "https://cdn.example.com/b.json?sig=1"},
+		},
+		{
+			name:   "array of strings",
+			config: &HttpManifestConfig{Url: srv.URL + "/strings.json"},
+			want:   []string{srv.URL + "/logs/c.json"},
+		},
+		{
+			name:    "missing url field",
+			config:  &HttpManifestConfig{Url: srv.URL + "/objects.json", ItemsPath: utils.ToPointer("data.files")},
+			wantErr: true,
+		},
+		{
+			name:    "items path is not an array",
+			config:  &HttpManifestConfig{Url: srv.URL + "/objects.json", ItemsPath: utils.ToPointer("data")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getHttpManifestUrls(context.Background(), s.get, tt.config)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_expandHttpUrlTemplate(t *testing.T) {
+	to := time.Date(2025, 1, 2, 1, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		template string
+		from     time.Time
+		want     []string
+	}{
+		{
+			name:     "daily",
+			template: "https://example.com/{year}/{month}/{day}.log",
+			from:     time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC),
+			want: []string{
+				"https://example.com/2024/12/31.log",
+				"https://example.com/2025/01/01.log",
+				"https://example.com/2025/01/02.log",
+			},
+		},
+		{
+			name:     "hourly",
+			template: "https://example.com/{year}{month}{day}-{hour}.log",
+			from:     time.Date(2025, 1, 1, 23, 59, 0, 0, time.UTC),
+			want: []string{
+				"https://example.com/20250101-23.log",
+				"https://example.com/20250102-00.log",
+				"https://example.com/20250102-01.log",
+			},
+		},
+		{
+			name:     "monthly",
+			template: "https://example.com/{year}-{month}.log",
+			from:     time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+			want:     []string{"https://example.com/2024-12.log", "https://example.com/2025-01.log"},
+		},
+		{
+			name:     "no placeholders",
+			template: "https://example.com/latest.log",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:     []string{"https://example.com/latest.log"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, expandHttpUrlTemplate(tt.template, tt.from, to))
+		})
+	}
+}
+
+func TestHttpSource_head(t *testing.T) {
+	const etag = `"v1"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/a.log" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+	}))
+	defer srv.Close()
+	s := newTestHttpSource(srv.Client())
+
+	tests := []struct {
+		name string
+		path string
+		prev *collection_state.HttpObjectState
+		want bool
+	}{
+		{name: "new artifact", path: "/a.log", want: true},
+		{name: "missing artifact", path: "/missing.log", want: false},
+		{name: "unchanged", path: "/a.log", prev: &collection_state.HttpObjectState{ETag: etag}, want: false},
+		{name: "changed", path: "/a.log", prev: &collection_state.HttpObjectState{ETag: `"v0"`}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.head(context.Background(), srv.URL+tt.path, tt.prev)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type httpTestObserver struct {
+	mut        sync.Mutex
+	downloaded []string
+}
+
+func (o *httpTestObserver) Notify(_ context.Context, e events.Event) error {
+	if d, ok := e.(*events.ArtifactDownloaded); ok {
+		o.mut.Lock()
+		o.downloaded = append(o.downloaded, d.Info.Name)
+		o.mut.Unlock()
+	}
+	return nil
+}
+
+// TestHttpSource_Collect_changedArtifact collects hourly artifacts generated from a URL template twice - the second
+// collection must collect the artifact for the current hour again, as it has changed, but not the previous hour
+func TestHttpSource_Collect_changedArtifact(t *testing.T) {
+	now := time.Now().UTC()
+	artifactPath := func(t time.Time) string {
+		return t.Format("/logs/2006/01/02/15.log")
+	}
+	current, previous := artifactPath(now), artifactPath(now.Add(-time.Hour))
+
+	var mut sync.Mutex
+	etags := map[string]string{current: `"v1"`, previous: `"v1"`}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		etag, ok := etags[r.URL.Path]
+		mut.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = fmt.Fprintln(w, "line", etag)
+	}))
+	defer srv.Close()
+
+	config := fmt.Sprintf(`
+urls        = ["%s/logs/{year}/{month}/{day}/{hour}.log"]
+file_layout = "logs/%%%%{YEAR:year}/%%%%{MONTHNUM:month}/%%%%{MONTHDAY:day}/%%%%{HOUR:hour}.log"
+`, srv.URL)
+	statePath := filepath.Join(t.TempDir(), "collection_state.json")
+	ctx := context_values.WithExecutionId(context.Background(), "exec")
+
+	collect := func() []string {
+		s := &HttpSource{}
+		s.RegisterSource(s)
+		observer := &httpTestObserver{}
+		_ = s.AddObserver(observer)
+
+		params := &row_source.RowSourceParams{
+			SourceConfigData:    types.NewSourceConfigData([]byte(config), hcl.Range{}, constants.HttpSourceIdentifier),
+			CollectionStatePath: statePath,
+			CollectionTempDir:   t.TempDir(),
+		}
+		require.NoError(t, s.Init(ctx, params))
+		require.NoError(t, s.Collect(ctx))
+		require.NoError(t, s.SaveCollectionState())
+
+		res := observer.downloaded
+		sort.Strings(res)
+		return res
+	}
+
+	want := []string{srv.URL + current, srv.URL + previous}
+	sort.Strings(want)
+	assert.Equal(t, want, collect())
+
+	// the current hour is still being written
+	mut.Lock()
+	etags[current] = `"v2"`
+	mut.Unlock()
+	assert.Equal(t, []string{srv.URL + current}, collect())
+}
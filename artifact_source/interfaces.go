@@ -25,6 +25,7 @@ type ArtifactSource interface {
 	SetRowPerLine(b bool)
 	SetSkipHeaderRow(b bool)
 	SetDefaultConfig(config *artifact_source_config.ArtifactSourceConfigImpl)
+	SetRetryPolicy(policy *RetryPolicy)
 }
 
 // Extractor is an interface which provides a method for extracting rows from an artifact
@@ -0,0 +1,226 @@
+package artifact_source
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+)
+
+// the maximum number of prefixes returned by LayoutTimePrefixes - if enumerating a time unit would exceed this,
+// the prefixes stop at the previous (coarser) unit
+const maxLayoutTimePrefixes = 10000
+
+// layoutTimeSegmentRegex matches a layout segment containing a single grok capture (with an optional literal prefix
+// and suffix), e.g. '%{YEAR:year}' or 'year=%{YEAR:year}'
+var layoutTimeSegmentRegex = regexp.MustCompile(`^([^%]*)%\{(\w+):(\w+)(?::\w+)?\}([^%]*)$`)
+
+// layoutTimePatterns are the grok patterns whose values can be enumerated for a time segment, and whether the
+// pattern also matches values which are not zero padded (e.g. '6' as well as '06')
+// time segments using any other pattern (or one of these patterns overridden by the source config) are not
+// enumerated, as the format of their values is not known
+// NOTE: YEAR also matches 2 digit years, but these cannot be parsed into artifact timestamps so are not enumerated
+var layoutTimePatterns = map[string]bool{
+	"YEAR":      false,
+	"MONTHNUM":  true,
+	"MONTHNUM2": false,
+	"MONTHDAY":  true,
+	"HOUR":      true,
+}
+
+// layoutTimeUnit is a time component of a file layout, in the order they must appear in the layout
+type layoutTimeUnit struct {
+	field string
+	// the value of the unit for a time, and the width it is zero padded to
+	value func(t time.Time) int
+	width int
+	// truncate the time to the start of the unit
+	truncate func(t time.Time) time.Time
+	next     func(t time.Time) time.Time
+}
+
+// format returns the representations of the unit value in a path - the zero padded value and, if the pattern
+// allows it and it differs, the value without padding
+func (u layoutTimeUnit) format(t time.Time, allowUnpadded bool) []string {
+	v := u.value(t)
+	padded := fmt.Sprintf("%0*d", u.width, v)
+	if unpadded := strconv.Itoa(v); allowUnpadded && unpadded != padded {
+		return []string{padded, unpadded}
+	}
+	return []string{padded}
+}
+
+var layoutTimeUnits = []layoutTimeUnit{
+	{
+		field:    constants.TemplateFieldYear,
+		value:    func(t time.Time) int { return t.Year() },
+		width:    4,
+		truncate: func(t time.Time) time.Time { return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC) },
+		next:     func(t time.Time) time.Time { return t.AddDate(1, 0, 0) },
+	},
+	{
+		field:    constants.TemplateFieldMonth,
+		value:    func(t time.Time) int { return int(t.Month()) },
+		width:    2,
+		truncate: func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC) },
+		next:     func(t time.Time) time.Time { return t.AddDate(0, 1, 0) },
+	},
+	{
+		field:    constants.TemplateFieldDay,
+		value:    func(t time.Time) int { return t.Day() },
+		width:    2,
+		truncate: func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC) },
+		next:     func(t time.Time) time.Time { return t.AddDate(0, 0, 1) },
+	},
+	{
+		field:    constants.TemplateFieldHour,
+		value:    func(t time.Time) int { return t.Hour() },
+		width:    2,
+		truncate: func(t time.Time) time.Time { return t.UTC().Truncate(time.Hour) },
+		next:     func(t time.Time) time.Time { return t.Add(time.Hour) },
+	},
+}
+
+// LayoutTimePrefixes returns the directory prefixes (relative to the base path, each with a trailing '/')
+// which may contain artifacts matching the layouts with a timestamp between from and to (or now, if to is zero)
+// This allows sources which list hierarchically to list only these prefixes, rather than walking from the root
+// and pruning directories outside the time range once they have been listed.
+//
+// The prefixes are made up of the literal segments at the start of the layout, followed by the time segments
+// (year, then month, day and hour).
+// The prefixes end at the first segment which is neither literal nor the next time unit captured with one of
+// layoutTimePatterns - if the pattern also matches values without zero padding, both forms are returned.
+// The layouts are the alternatives of the file layout (see ExpandPatternIntoOptionalAlternatives) - the prefixes
+// for all of them are returned. patterns are the custom grok patterns of the source.
+//
+// Returns nil if there is no from time, or if any of the layouts does not start with (optional) literal segments
+// followed by a year segment - in this case the source must walk from the root.
+func LayoutTimePrefixes(layouts []string, patterns map[string]string, from, to time.Time) []string {
+	if from.IsZero() {
+		return nil
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	from = from.UTC()
+	to = to.UTC()
+	if from.After(to) {
+		return nil
+	}
+
+	var res []string
+	seen := make(map[string]struct{})
+	for _, layout := range layouts {
+		prefixes := layoutTimePrefixes(layout, patterns, from, to)
+		if prefixes == nil {
+			return nil
+		}
+		for _, p := range prefixes {
+			if _, ok := seen[p]; !ok {
+				seen[p] = struct{}{}
+				res = append(res, p)
+			}
+		}
+	}
+	return res
+}
+
+// layoutTimePrefixes returns the time prefixes for a single (expanded) layout
+func layoutTimePrefixes(layout string, patterns map[string]string, from, to time.Time) []string {
+	parts := strings.Split(layout, "/")
+	// the final segment is the file name
+	parts = parts[:len(parts)-1]
+
+	// the prefixes enumerated so far, grouped by the time value they were enumerated for (in time order)
+	// - each group is combined with the values of the next time unit
+	prefixGroups := [][]string{{""}}
+	// the number of time units enumerated
+	depth := 0
+	for _, part := range parts {
+		if part != "" && isLiteralLayoutSegment(part) {
+			for _, group := range prefixGroups {
+				for i := range group {
+					group[i] += part + "/"
+				}
+			}
+			continue
+		}
+
+		// is this segment the next time unit
+		match := layoutTimeSegmentRegex.FindStringSubmatch(part)
+		if depth == len(layoutTimeUnits) || match == nil || match[3] != layoutTimeUnits[depth].field ||
+			!isLiteralLayoutSegment(match[1]) || !isLiteralLayoutSegment(match[4]) {
+			break
+		}
+		// we can only enumerate the values if we know the format the pattern matches
+		allowUnpadded, ok := layoutTimePatterns[match[2]]
+		if _, overridden := patterns[match[2]]; !ok || overridden {
+			slog.Debug("Cannot enumerate time prefixes for layout segment with unknown value format", "layout", layout, "segment", part)
+			break
+		}
+		next := enumerateTimePrefixes(prefixGroups, layoutTimeUnits[:depth+1], from, to, match[1], match[4], allowUnpadded)
+		if next == nil {
+			break
+		}
+		prefixGroups = next
+		depth++
+	}
+
+	// we must have at least a year
+	if depth == 0 {
+		return nil
+	}
+	var res []string
+	for _, group := range prefixGroups {
+		res = append(res, group...)
+	}
+	return res
+}
+
+// enumerateTimePrefixes extends the prefix groups with each value of the last of the given time units between
+// from and to (the groups correspond to the values of the previous unit, in time order)
+// returns nil if this would exceed maxLayoutTimePrefixes
+func enumerateTimePrefixes(prefixGroups [][]string, units []layoutTimeUnit, from, to time.Time, segmentPrefix, segmentSuffix string, allowUnpadded bool) [][]string {
+	unit := units[len(units)-1]
+	var parent *layoutTimeUnit
+	if len(units) > 1 {
+		parent = &units[len(units)-2]
+	}
+
+	var res [][]string
+	count := 0
+	parentIdx := 0
+	var parentStart time.Time
+	for t := unit.truncate(from); !t.After(to); t = unit.next(t) {
+		// move on to the parent prefix group containing this time
+		if parent != nil {
+			start := parent.truncate(t)
+			if !parentStart.IsZero() && start.After(parentStart) {
+				parentIdx++
+			}
+			parentStart = start
+		}
+		var group []string
+		for _, prefix := range prefixGroups[parentIdx] {
+			for _, value := range unit.format(t, allowUnpadded) {
+				group = append(group, prefix+segmentPrefix+value+segmentSuffix+"/")
+			}
+		}
+		res = append(res, group)
+		count += len(group)
+		if count > maxLayoutTimePrefixes {
+			return nil
+		}
+	}
+	return res
+}
+
+// isLiteralLayoutSegment returns whether the layout segment is literal, i.e. it contains no grok captures
+// or regex metacharacters
+func isLiteralLayoutSegment(part string) bool {
+	return !strings.Contains(part, "%{") && regexp.QuoteMeta(part) == part
+}
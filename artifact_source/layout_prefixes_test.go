@@ -0,0 +1,142 @@
+package artifact_source
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayoutTimePrefixes(t *testing.T) {
+	from := time.Date(2024, 12, 30, 22, 15, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		layouts  []string
+		patterns map[string]string
+		from     time.Time
+		to       time.Time
+		want     []string
+	}{
+		{
+			name:    "year month day",
+			layouts: []string{"logs/%{YEAR:year}/%{MONTHNUM:month}/%{MONTHDAY:day}/%{DATA}.log"},
+			from:    from,
+			to:      to,
+			// values which are not zero padded are also matched by MONTHNUM and MONTHDAY
+			want: []string{"logs/2024/12/30/", "logs/2024/12/31/", "logs/2025/01/01/", "logs/2025/01/1/", "logs/2025/1/01/", "logs/2025/1/1/"},
+		},
+		{
+			name:    "hour",
+			layouts: []string{"%{YEAR:year}/%{MONTHNUM2:month}/%{MONTHDAY:day}/%{HOUR:hour}/%{DATA}.log"},
+			from:    time.Date(2024, 12, 31, 22, 15, 0, 0, time.UTC),
+			to:      to,
+			want: []string{
+				"2024/12/31/22/", "2024/12/31/23/",
+				"2025/01/01/00/", "2025/01/01/0/", "2025/01/1/00/", "2025/01/1/0/",
+				"2025/01/01/01/", "2025/01/01/1/", "2025/01/1/01/", "2025/01/1/1/",
+			},
+		},
+		{
+			name:    "zero padded patterns",
+			layouts: []string{"logs/%{YEAR:year}/%{MONTHNUM2:month}/%{DATA}.log"},
+			from:    from,
+			to:      to,
+			want:    []string{"logs/2024/12/", "logs/2025/01/"},
+		},
+		{
+			name:    "stops at time segment with unknown format",
+			layouts: []string{"logs/%{YEAR:year}/%{INT:month}/%{DATA}.log"},
+			from:    from,
+			to:      to,
+			want:    []string{"logs/2024/", "logs/2025/"},
+		},
+		{
+			name:     "stops at time segment with overridden pattern",
+			layouts:  []string{"logs/%{YEAR:year}/%{MONTHNUM:month}/%{DATA}.log"},
+			patterns: map[string]string{"MONTHNUM": `\d{1,2}`},
+			from:     from,
+			to:       to,
+			want:     []string{"logs/2024/", "logs/2025/"},
+		},
+		{
+			name:    "hive style segments and literal after time",
+			layouts: []string{"year=%{YEAR:year}/month=%{MONTHNUM:month}/logs/%{DATA}.log"},
+			from:    from,
+			to:      to,
+			want:    []string{"year=2024/month=12/logs/", "year=2025/month=01/logs/", "year=2025/month=1/logs/"},
+		},
+		{
+			name:    "stops at non time segment",
+			layouts: []string{"AWSLogs/%{YEAR:year}/%{NUMBER:account_id}/%{MONTHNUM:month}/%{DATA}.json.gz"},
+			from:    from,
+			to:      to,
+			want:    []string{"AWSLogs/2024/", "AWSLogs/2025/"},
+		},
+		{
+			name:    "stops at out of order time segment",
+			layouts: []string{"%{YEAR:year}/%{MONTHDAY:day}/%{DATA}.log"},
+			from:    from,
+			to:      to,
+			want:    []string{"2024/", "2025/"},
+		},
+		{
+			name: "alternatives",
+			layouts: []string{
+				"logs/%{YEAR:year}/%{DATA}.log",
+				"logs/%{YEAR:year}/%{MONTHNUM:month}/%{DATA}.log",
+			},
+			from: from,
+			to:   to,
+			want: []string{"logs/2024/", "logs/2025/", "logs/2024/12/", "logs/2025/01/", "logs/2025/1/"},
+		},
+		{
+			name:    "time segments do not follow literal prefix",
+			layouts: []string{"AWSLogs/%{NUMBER:account_id}/%{YEAR:year}/%{DATA}.json.gz"},
+			from:    from,
+			to:      to,
+		},
+		{
+			name:    "one alternative without time segments",
+			layouts: []string{"logs/%{YEAR:year}/%{DATA}.log", "logs/%{DATA}.log"},
+			from:    from,
+			to:      to,
+		},
+		{
+			name:    "no from time",
+			layouts: []string{"logs/%{YEAR:year}/%{DATA}.log"},
+			to:      to,
+		},
+		{
+			name:    "too many prefixes",
+			layouts: []string{"%{YEAR:year}/%{MONTHNUM:month}/%{MONTHDAY:day}/%{HOUR:hour}/%{DATA}.log"},
+			from:    time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			to:      time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+			// enumerating the hours exceeds the limit, so the prefixes stop at the days
+			want: func() []string {
+				var res []string
+				formats := func(v int) []string {
+					if v < 10 {
+						return []string{fmt.Sprintf("%02d", v), fmt.Sprint(v)}
+					}
+					return []string{fmt.Sprint(v)}
+				}
+				for d := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC); !d.After(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)); d = d.AddDate(0, 0, 1) {
+					for _, month := range formats(int(d.Month())) {
+						for _, day := range formats(d.Day()) {
+							res = append(res, fmt.Sprintf("%d/%s/%s/", d.Year(), month, day))
+						}
+					}
+				}
+				return res
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LayoutTimePrefixes(tt.layouts, tt.patterns, tt.from, tt.to))
+		})
+	}
+}
@@ -2,6 +2,9 @@ package artifact_source
 
 import (
 	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/collection_state"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
 )
 
 // NilArtifactCollectionState is a collection state that does nothing
@@ -34,10 +37,18 @@ func (*NilArtifactCollectionState) ShouldCollect(_ string, _ time.Time) bool {
 	return false
 }
 
+func (*NilArtifactCollectionState) ShouldCollectArtifact(_ string, _ time.Time, _ *types.ArtifactVersion) (collection_state.ShouldCollectResult, *types.ArtifactVersion) {
+	return collection_state.ShouldCollectSkip, nil
+}
+
 func (*NilArtifactCollectionState) OnCollected(_ string, _ time.Time) error {
 	return nil
 }
 
+func (*NilArtifactCollectionState) OnArtifactCollected(_ string, _ time.Time, _ *types.ArtifactVersion) error {
+	return nil
+}
+
 func (*NilArtifactCollectionState) SetGranularity(_ time.Duration) {
 }
 
@@ -1,6 +1,7 @@
 package artifact_source
 
 import (
+	"github.com/turbot/pipe-fittings/v2/filter"
 	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
 )
 
@@ -33,5 +34,37 @@ func (n NilArtifactSourceConfig) GetFileLayout() *string {
 	return nil
 }
 
+func (n NilArtifactSourceConfig) GetFileLayouts() []string {
+	return nil
+}
+
+func (n NilArtifactSourceConfig) GetPatterns() map[string]string {
+	return nil
+}
+
+func (n NilArtifactSourceConfig) GetFilterMap() map[string]*filter.SqlFilter {
+	return nil
+}
+
+func (n NilArtifactSourceConfig) GetExclude() []string {
+	return nil
+}
+
 func (n NilArtifactSourceConfig) DefaultTo(_ artifact_source_config.ArtifactSourceConfig) {
 }
+
+func (n NilArtifactSourceConfig) GetChecksumConfig() *artifact_source_config.ChecksumConfig {
+	return nil
+}
+
+func (n NilArtifactSourceConfig) GetChangePolicy() artifact_source_config.ChangePolicy {
+	return artifact_source_config.ChangePolicyIgnore
+}
+
+func (n NilArtifactSourceConfig) GetTempDirMaxSize() int64 {
+	return 0
+}
+
+func (n NilArtifactSourceConfig) GetLimiterConfig() *artifact_source_config.LimiterConfig {
+	return nil
+}
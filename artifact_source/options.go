@@ -61,3 +61,14 @@ func WithSkipHeaderRow() row_source.RowSourceOption {
 		return nil
 	}
 }
+
+// WithRetryPolicy is used when creating an ArtifactSourceImpl
+// it specifies how failed artifact downloads are retried (if not set, DefaultRetryPolicy is used)
+func WithRetryPolicy(policy *RetryPolicy) row_source.RowSourceOption {
+	return func(r row_source.RowSource) error {
+		if a, ok := r.(ArtifactSource); ok {
+			a.SetRetryPolicy(policy)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,143 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"sync"
+)
+
+// DefaultWalkConcurrency is the number of directories listed in parallel by WalkParallel, if not specified
+const DefaultWalkConcurrency = 8
+
+// DirEntry is an entry in a directory listing (see DirLister)
+type DirEntry struct {
+	Path  string
+	IsDir bool
+}
+
+// DirLister lists the entries directly below a directory
+type DirLister func(ctx context.Context, dir string) ([]DirEntry, error)
+
+// WalkParallel walks the hierarchy below root (which is not itself visited), calling visit for each entry
+// this may be used by any source which lists hierarchically (e.g. remote file systems or object stores),
+// passing WalkNode (or a function which calls it) as visit
+//
+// Sibling directories are listed in parallel by up to concurrency workers, so visit may be called concurrently.
+// The entries of each directory are visited (in order) by the worker which listed it.
+// If visit returns fs.SkipDir for a directory, the directory is not listed. If it returns fs.SkipDir for a file,
+// the remaining entries in the directory are skipped (as with filepath.WalkDir).
+// The walk stops at the first error returned by list or visit.
+func WalkParallel(ctx context.Context, root string, concurrency int, list DirLister, visit func(path string, isDir bool) error) error {
+	if concurrency <= 0 {
+		concurrency = DefaultWalkConcurrency
+	}
+	w := &parallelWalker{
+		list:    list,
+		visit:   visit,
+		queue:   []string{root},
+		pending: 1,
+	}
+	w.cond = sync.NewCond(&w.mut)
+
+	// wake the workers if the context is cancelled, so they stop waiting for directories
+	stop := context.AfterFunc(ctx, func() {
+		w.setError(ctx.Err())
+	})
+	defer stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	return w.err
+}
+
+// parallelWalker holds the state of a WalkParallel walk
+type parallelWalker struct {
+	list  DirLister
+	visit func(path string, isDir bool) error
+
+	mut  sync.Mutex
+	cond *sync.Cond
+	// the directories waiting to be listed
+	queue []string
+	// the number of directories which are queued or being listed - the walk is complete when this is zero
+	pending int
+	// the first error - once set, the walk stops
+	err error
+}
+
+func (w *parallelWalker) work(ctx context.Context) {
+	for {
+		w.mut.Lock()
+		for len(w.queue) == 0 && w.pending > 0 && w.err == nil {
+			w.cond.Wait()
+		}
+		if w.pending == 0 || w.err != nil {
+			w.mut.Unlock()
+			return
+		}
+		// take the most recently queued directory, so the walk is depth first
+		dir := w.queue[len(w.queue)-1]
+		w.queue = w.queue[:len(w.queue)-1]
+		w.mut.Unlock()
+
+		if err := w.walkDir(ctx, dir); err != nil {
+			w.setError(err)
+		}
+
+		w.mut.Lock()
+		w.pending--
+		w.cond.Broadcast()
+		w.mut.Unlock()
+	}
+}
+
+// walkDir lists the directory and visits its entries, queueing any subdirectories to be walked
+func (w *parallelWalker) walkDir(ctx context.Context, dir string) error {
+	entries, err := w.list(ctx, dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := w.visit(entry.Path, entry.IsDir)
+		if errors.Is(err, fs.SkipDir) {
+			if entry.IsDir {
+				continue
+			}
+			// skip the remaining entries of this directory
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if entry.IsDir {
+			w.mut.Lock()
+			w.queue = append(w.queue, entry.Path)
+			w.pending++
+			w.cond.Signal()
+			w.mut.Unlock()
+		}
+	}
+	return nil
+}
+
+func (w *parallelWalker) setError(err error) {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.err == nil {
+		w.err = err
+	}
+	w.cond.Broadcast()
+}
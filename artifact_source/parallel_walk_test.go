@@ -0,0 +1,105 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testTree is an in-memory hierarchy - paths ending in '/' are directories
+type testTree struct {
+	paths []string
+
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func (tr *testTree) list(_ context.Context, dir string) ([]DirEntry, error) {
+	// track the number of concurrent listings
+	active := tr.active.Add(1)
+	defer tr.active.Add(-1)
+	for {
+		current := tr.maxActive.Load()
+		if active <= current || tr.maxActive.CompareAndSwap(current, active) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+
+	var entries []DirEntry
+	for _, p := range tr.paths {
+		if path.Dir(strings.TrimSuffix(p, "/")) == dir {
+			entries = append(entries, DirEntry{Path: strings.TrimSuffix(p, "/"), IsDir: strings.HasSuffix(p, "/")})
+		}
+	}
+	if dir == "root/error" {
+		return nil, errors.New("list failed")
+	}
+	return entries, nil
+}
+
+func TestWalkParallel(t *testing.T) {
+	tree := &testTree{}
+	for _, year := range []string{"2023", "2024"} {
+		tree.paths = append(tree.paths, "root/"+year+"/")
+		for _, month := range []string{"01", "02", "03", "04", "05", "06"} {
+			tree.paths = append(tree.paths, "root/"+year+"/"+month+"/", "root/"+year+"/"+month+"/a.log", "root/"+year+"/"+month+"/b.log")
+		}
+	}
+
+	var mut sync.Mutex
+	var got []string
+	err := WalkParallel(context.Background(), "root", 4, tree.list, func(p string, isDir bool) error {
+		mut.Lock()
+		defer mut.Unlock()
+		got = append(got, p)
+		if isDir && p == "root/2023" {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// the skipped directory is visited, but not descended into
+	var want []string
+	for _, p := range tree.paths {
+		if p == "root/2023/" || !strings.HasPrefix(p, "root/2023/") {
+			want = append(want, strings.TrimSuffix(p, "/"))
+		}
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	assert.Equal(t, want, got)
+	assert.LessOrEqual(t, tree.maxActive.Load(), int32(4))
+	assert.Greater(t, tree.maxActive.Load(), int32(1))
+
+	// errors stop the walk
+	tree.paths = append(tree.paths, "root/error/")
+	err = WalkParallel(context.Background(), "root", 4, tree.list, func(string, bool) error { return nil })
+	assert.EqualError(t, err, "list failed")
+	tree.paths = tree.paths[:len(tree.paths)-1]
+
+	visitErr := errors.New("visit failed")
+	err = WalkParallel(context.Background(), "root", 4, tree.list, func(p string, _ bool) error {
+		if p == "root/2024/03/a.log" {
+			return visitErr
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, visitErr)
+
+	// as does cancellation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = WalkParallel(ctx, "root", 4, tree.list, func(string, bool) error { return nil })
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -84,6 +84,7 @@ func (w *PluginSourceWrapper) Init(ctx context.Context, params *row_source.RowSo
 
 	w.FromTime = fromTime.Time
 	w.FromTimeSource = fromTime.Source
+	w.ToTime = params.To
 
 	return nil
 }
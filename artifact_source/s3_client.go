@@ -0,0 +1,207 @@
+package artifact_source
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/smithy-go/encoding/httpbinding"
+)
+
+// the hex encoded sha256 of an empty payload - all requests made by the s3Client have an empty body
+const s3EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// s3Client is a minimal client for the S3 REST API, supporting the requests needed by the S3Source
+// (ListObjectsV2 and GetObject), signed with AWS signature version 4
+type s3Client struct {
+	httpClient  *http.Client
+	endpoint    *url.URL
+	bucket      string
+	region      string
+	pathStyle   bool
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+}
+
+func newS3Client(ctx context.Context, config *S3SourceConfig) (*s3Client, error) {
+	var provider aws.CredentialsProvider
+	if config.AccessKey != nil {
+		var sessionToken string
+		if config.SessionToken != nil {
+			sessionToken = *config.SessionToken
+		}
+		provider = credentials.NewStaticCredentialsProvider(*config.AccessKey, *config.SecretKey, sessionToken)
+	} else {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(config.GetRegion()))
+		if err != nil {
+			return nil, fmt.Errorf("error loading AWS config: %w", err)
+		}
+		provider = cfg.Credentials
+	}
+
+	return &s3Client{
+		httpClient:  &http.Client{},
+		endpoint:    config.GetEndpoint(),
+		bucket:      config.Bucket,
+		region:      config.GetRegion(),
+		pathStyle:   config.GetUsePathStyle(),
+		credentials: aws.NewCredentialsCache(provider),
+		signer:      v4.NewSigner(),
+	}, nil
+}
+
+// s3Object is an object returned by ListObjectsV2
+type s3Object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	ETag         string    `xml:"ETag"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+// s3ListResult is the result of a single ListObjectsV2 request
+type s3ListResult struct {
+	Contents       []s3Object `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// s3Error is an error response returned by the object store
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	// the delay requested by the Retry-After header (if any)
+	RetryAfter time.Duration `xml:"-"`
+}
+
+func (e *s3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("s3 request failed: %s", http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("s3 request failed: %s: %s", e.Code, e.Message)
+}
+
+// listPrefix lists the objects and common prefixes directly below the given prefix (using '/' as the delimiter),
+// calling fn for each page of results
+func (c *s3Client) listPrefix(ctx context.Context, prefix string, fn func(*s3ListResult) error) error {
+	var continuationToken string
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("delimiter", "/")
+		query.Set("prefix", prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		u := c.objectUrl("")
+		u.RawQuery = query.Encode()
+
+		resp, err := c.do(ctx, u, nil)
+		if err != nil {
+			return fmt.Errorf("error listing prefix '%s': %w", prefix, err)
+		}
+		var res s3ListResult
+		err = xml.NewDecoder(resp.Body).Decode(&res)
+		_ = resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error decoding list response for prefix '%s': %w", prefix, err)
+		}
+
+		if err := fn(&res); err != nil {
+			return err
+		}
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			return nil
+		}
+		continuationToken = res.NextContinuationToken
+	}
+}
+
+// getObject returns the response for a GetObject request - the caller must close the response body
+func (c *s3Client) getObject(ctx context.Context, key string) (*http.Response, error) {
+	resp, err := c.do(ctx, c.objectUrl(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting object '%s': %w", key, err)
+	}
+	return resp, nil
+}
+
+// getObjectRange returns the response for a GetObject request for the object data from offset onwards
+// if etag is set, the request fails with a 412 (precondition failed) error if the object has changed
+func (c *s3Client) getObjectRange(ctx context.Context, key string, offset int64, etag string) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+	resp, err := c.do(ctx, c.objectUrl(key), header)
+	if err != nil {
+		return nil, fmt.Errorf("error getting object '%s': %w", key, err)
+	}
+	return resp, nil
+}
+
+// objectUrl returns the URL of the given key (or of the bucket, if the key is empty),
+// using either path style or virtual hosted style addressing
+func (c *s3Client) objectUrl(key string) *url.URL {
+	u := *c.endpoint
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if c.pathStyle {
+		u.Path = basePath + "/" + c.bucket + "/" + key
+	} else {
+		u.Host = c.bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+	}
+	// S3 requires every character other than the unreserved characters to be escaped
+	u.RawPath = httpbinding.EscapePath(u.Path, false)
+	return &u
+}
+
+// do makes a signed GET request (with any additional headers), returning an error if the response status is not 2xx
+func (c *s3Client) do(ctx context.Context, u *url.URL, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credentials: %w", err)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", s3EmptyPayloadHash)
+	err = c.signer.SignHTTP(ctx, creds, req, s3EmptyPayloadHash, "s3", c.region, time.Now(), func(o *v4.SignerOptions) {
+		// the path has already been escaped as S3 expects
+		o.DisableURIPathEscaping = true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error signing request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		s3Err := &s3Error{StatusCode: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+		// the body usually contains an XML error document - if it does not, just report the status
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		_ = xml.Unmarshal(body, s3Err)
+		return nil, s3Err
+	}
+	return resp, nil
+}
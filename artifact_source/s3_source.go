@@ -0,0 +1,341 @@
+package artifact_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/pipe-fittings/v2/filter"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/row_source"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// S3Source is an [ArtifactSource] implementation which collects artifacts from AWS S3 or any S3 compatible
+// object store (e.g. MinIO), using the S3 REST API
+//
+// The bucket is listed one level at a time (using '/' as the delimiter), and the file layout is applied to each
+// prefix as it is listed, so only the prefixes which may contain matching artifacts are listed.
+// Listing starts at the longest literal prefix of the file layout.
+//
+// NOTE: the source is not registered automatically - plugins which want to provide it should register it
+// from their package init function:
+//
+//	row_source.RegisterRowSource[*artifact_source.S3Source]()
+type S3Source struct {
+	ArtifactSourceImpl[*S3SourceConfig, *EmptyConnection]
+
+	client *s3Client
+	// the lister used for discovery - this records the objects listed so their versions are available
+	lister *s3VersionLister
+	// map of object key to the ETag used to resume a partial download
+	resumeETags sync.Map
+}
+
+func (s *S3Source) Init(ctx context.Context, params *row_source.RowSourceParams, opts ...row_source.RowSourceOption) error {
+	// call base to parse config and apply options
+	if err := s.ArtifactSourceImpl.Init(ctx, params, opts...); err != nil {
+		return err
+	}
+
+	client, err := newS3Client(ctx, s.Config)
+	if err != nil {
+		return err
+	}
+	s.client = client
+
+	slog.Info("Initialized S3Source", "bucket", s.Config.Bucket, "prefix", s.Config.GetPrefix(), "endpoint", client.endpoint.String(), "path style", client.pathStyle, "layouts", s.Config.GetFileLayouts())
+	return nil
+}
+
+func (s *S3Source) Identifier() string {
+	return constants.S3SourceIdentifier
+}
+
+func (s *S3Source) Description() (string, error) {
+	// the config is not set if the source has not been initialised
+	if s.Config == nil {
+		return "Collect artifacts from an S3 compatible bucket.", nil
+	}
+	return fmt.Sprintf("Collect artifacts from S3 compatible bucket '%s'.", s.Config.Bucket) + s.describeDownloadLimits(), nil
+}
+
+// DiscoverArtifacts lists the bucket, starting at the prefixes of the file layout which may contain artifacts
+// between the from and to times (or the literal prefix of the layout, if these cannot be determined),
+// calling WalkNode for every object and prefix
+func (s *S3Source) DiscoverArtifacts(ctx context.Context) error {
+	layouts, g, err := newLayoutMatcher(s.Config.GetFileLayouts(), s.Config.GetPatterns())
+	if err != nil {
+		return err
+	}
+	filterMap := make(map[string]*filter.SqlFilter)
+
+	basePath := s.Config.GetPrefix()
+	startPrefixes := LayoutTimePrefixes(layouts, s.Config.GetPatterns(), s.FromTime, s.ToTime)
+	if startPrefixes == nil {
+		startPrefixes = []string{s3LayoutPrefix(layouts)}
+	}
+	slog.Debug("S3Source DiscoverArtifacts", "prefixes", len(startPrefixes))
+
+	s.lister = &s3VersionLister{s3Lister: s.client, objects: make(map[string]s3Object)}
+	for _, prefix := range startPrefixes {
+		err := walkS3Prefix(ctx, s.lister, basePath+prefix, func(targetPath string, isDir bool) error {
+			err := s.WalkNode(ctx, targetPath, basePath, layouts, isDir, g, filterMap)
+			if !isDir {
+				// the object version is only needed while the object is being visited
+				s.lister.forget(targetPath)
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DownloadArtifact downloads the object to the temp directory
+func (s *S3Source) DownloadArtifact(ctx context.Context, info *types.ArtifactInfo) error {
+	resp, err := s.client.getObject(ctx, info.Name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := os.MkdirAll(s.TempDir, 0755); err != nil {
+		return fmt.Errorf("error creating temp directory %s: %w", s.TempDir, err)
+	}
+	// keep the object name as a suffix so the loader is chosen based on the original extension
+	f, err := os.CreateTemp(s.TempDir, "*-"+path.Base(info.Name))
+	if err != nil {
+		return fmt.Errorf("error creating temp file for %s: %w", info.Name, err)
+	}
+	return s.writeArtifact(ctx, info, resp, f, 0)
+}
+
+// ResumeArtifactDownload requests the remainder of a partially downloaded object using a range request
+// the ETag of the original response is used to ensure the object has not changed since the download started
+func (s *S3Source) ResumeArtifactDownload(ctx context.Context, info *types.ArtifactInfo, partial *PartialDownloadError) error {
+	etag, _ := s.resumeETags.LoadAndDelete(info.Name)
+	etagStr, _ := etag.(string)
+
+	resp, err := s.client.getObjectRange(ctx, info.Name, partial.Offset, etagStr)
+	if err != nil {
+		var s3Err *s3Error
+		if errors.As(err, &s3Err) && (s3Err.StatusCode == http.StatusPreconditionFailed || s3Err.StatusCode == http.StatusRequestedRangeNotSatisfiable) {
+			// the object has changed - start again
+			slog.Debug("Object has changed - restarting download", "key", info.Name)
+			_ = os.Remove(partial.LocalPath)
+			return s.DownloadArtifact(ctx, info)
+		}
+		if s.IsRetryableError(err) {
+			// keep the partial download (and ETag) so the next attempt can resume
+			if etagStr != "" {
+				s.resumeETags.Store(info.Name, etagStr)
+			}
+			return &PartialDownloadError{LocalPath: partial.LocalPath, Offset: partial.Offset, Err: err}
+		}
+		_ = os.Remove(partial.LocalPath)
+		return err
+	}
+	defer resp.Body.Close()
+
+	var offset int64
+	if resp.StatusCode == http.StatusPartialContent {
+		offset = partial.Offset
+	}
+	f, err := os.OpenFile(partial.LocalPath, os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(offset); err == nil {
+		_, err = f.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(partial.LocalPath)
+		return err
+	}
+	return s.writeArtifact(ctx, info, resp, f, offset)
+}
+
+// writeArtifact writes the response body to the local file (which already contains offset bytes of the object),
+// then notifies that the artifact has been downloaded
+func (s *S3Source) writeArtifact(ctx context.Context, info *types.ArtifactInfo, resp *http.Response, f *os.File, offset int64) error {
+	size, err := copyArtifactData(f, s.limitBandwidth(ctx, resp.Body), offset)
+	if err != nil {
+		var partialErr *PartialDownloadError
+		if errors.As(err, &partialErr) {
+			if etag := resp.Header.Get("ETag"); etag != "" {
+				s.resumeETags.Store(info.Name, etag)
+			}
+		}
+		return fmt.Errorf("error downloading %s: %w", info.Name, err)
+	}
+
+	downloadInfo := types.NewDownloadedArtifactInfo(info, f.Name(), size)
+	// the version is taken from the response, so it is the version of the data which was downloaded
+	downloadInfo.Version = &types.ArtifactVersion{Size: size, ETag: resp.Header.Get("ETag")}
+	if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		downloadInfo.Version.ModTime = lastModified
+	}
+	return s.OnArtifactDownloaded(ctx, downloadInfo)
+}
+
+// GetArtifactVersion implements ArtifactVersioner - the version is taken from the listing of the object
+func (s *S3Source) GetArtifactVersion(_ context.Context, name string) (*types.ArtifactVersion, error) {
+	if s.lister == nil {
+		return nil, nil
+	}
+	obj, ok := s.lister.getObject(name)
+	if !ok {
+		return nil, nil
+	}
+	return &types.ArtifactVersion{Size: obj.Size, ModTime: obj.LastModified, ETag: obj.ETag}, nil
+}
+
+// OpenDigestFile implements DigestFileSource
+func (s *S3Source) OpenDigestFile(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := s.client.getObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// IsRetryableError implements RetryableErrorClassifier
+// in addition to the default classification, throttling, request timeouts and server errors are retryable
+func (s *S3Source) IsRetryableError(err error) bool {
+	var s3Err *s3Error
+	if errors.As(err, &s3Err) {
+		return s3Err.StatusCode >= 500 ||
+			s3Err.StatusCode == http.StatusTooManyRequests ||
+			s3Err.Code == "SlowDown" ||
+			s3Err.Code == "RequestTimeout"
+	}
+	return IsRetryableError(err)
+}
+
+// IsThrottlingError implements ThrottlingErrorClassifier
+// SlowDown errors, and rate limiting (429) and service unavailable (503) responses indicate throttling
+func (s *S3Source) IsThrottlingError(err error) (bool, time.Duration) {
+	var s3Err *s3Error
+	if errors.As(err, &s3Err) && (s3Err.Code == "SlowDown" ||
+		s3Err.StatusCode == http.StatusTooManyRequests ||
+		s3Err.StatusCode == http.StatusServiceUnavailable) {
+		return true, s3Err.RetryAfter
+	}
+	return false, 0
+}
+
+// s3Lister is implemented by s3Client - it lists the objects and common prefixes directly below a prefix
+type s3Lister interface {
+	listPrefix(ctx context.Context, prefix string, fn func(*s3ListResult) error) error
+}
+
+// s3VersionLister wraps an s3Lister, recording the objects which have been listed but not yet visited,
+// so the version of an object can be determined from the listing
+type s3VersionLister struct {
+	s3Lister
+
+	mut     sync.Mutex
+	objects map[string]s3Object
+}
+
+func (l *s3VersionLister) listPrefix(ctx context.Context, prefix string, fn func(*s3ListResult) error) error {
+	return l.s3Lister.listPrefix(ctx, prefix, func(res *s3ListResult) error {
+		l.mut.Lock()
+		for _, obj := range res.Contents {
+			l.objects[obj.Key] = obj
+		}
+		l.mut.Unlock()
+		return fn(res)
+	})
+}
+
+func (l *s3VersionLister) getObject(key string) (s3Object, bool) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	obj, ok := l.objects[key]
+	return obj, ok
+}
+
+// forget removes the object once it has been visited
+func (l *s3VersionLister) forget(key string) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	delete(l.objects, key)
+}
+
+// walkS3Prefix walks the objects below the given prefix, calling visit for each object and each common prefix
+// (with the trailing '/' removed)
+// prefixes are listed in parallel (see WalkParallel), so visit may be called concurrently
+// if visit returns fs.SkipDir for a prefix, the objects below it are not listed
+func walkS3Prefix(ctx context.Context, lister s3Lister, prefix string, visit func(targetPath string, isDir bool) error) error {
+	// the entries passed to the walker keep the trailing '/' of common prefixes, so they can be listed
+	list := func(ctx context.Context, dir string) ([]DirEntry, error) {
+		var entries []DirEntry
+		err := lister.listPrefix(ctx, dir, func(res *s3ListResult) error {
+			for _, obj := range res.Contents {
+				// skip 'directory marker' objects
+				if strings.HasSuffix(obj.Key, "/") {
+					continue
+				}
+				entries = append(entries, DirEntry{Path: obj.Key})
+			}
+			for _, p := range res.CommonPrefixes {
+				entries = append(entries, DirEntry{Path: p.Prefix, IsDir: true})
+			}
+			return nil
+		})
+		return entries, err
+	}
+	return WalkParallel(ctx, prefix, DefaultWalkConcurrency, list, func(targetPath string, isDir bool) error {
+		return visit(strings.TrimSuffix(targetPath, "/"), isDir)
+	})
+}
+
+// s3LayoutPrefix returns the longest prefix (of whole path segments) which is literal in all of the given layouts
+// - nothing outside this prefix can match the layouts, so there is no need to list it
+func s3LayoutPrefix(layouts []string) string {
+	var common []string
+	for i, layout := range layouts {
+		parts := strings.Split(layout, "/")
+		// the final segment is the file name
+		parts = parts[:len(parts)-1]
+
+		var literal []string
+		for _, part := range parts {
+			if part == "" || strings.Contains(part, "%{") || regexp.QuoteMeta(part) != part {
+				break
+			}
+			literal = append(literal, part)
+		}
+
+		if i == 0 {
+			common = literal
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(literal) && common[n] == literal[n] {
+			n++
+		}
+		common = common[:n]
+	}
+
+	if len(common) == 0 {
+		return ""
+	}
+	return strings.Join(common, "/") + "/"
+}
@@ -0,0 +1,97 @@
+package artifact_source
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+)
+
+// S3SourceConfig is the config for the S3Source
+// the source works with AWS S3 and S3 compatible object stores (e.g. MinIO)
+type S3SourceConfig struct {
+	artifact_source_config.ArtifactSourceConfigImpl
+	// required to allow partial decoding
+	Remain hcl.Body `hcl:",remain" json:"-"`
+
+	// the bucket to collect from
+	Bucket string `hcl:"bucket"`
+	// the prefix within the bucket - the file layout is applied to object keys relative to this prefix
+	Prefix *string `hcl:"prefix,optional"`
+	// the region of the bucket (defaults to us-east-1)
+	Region *string `hcl:"region,optional"`
+	// the endpoint of the object store, e.g. http://localhost:9000 (defaults to the AWS S3 endpoint for the region)
+	Endpoint *string `hcl:"endpoint,optional"`
+	// whether to use path style addressing (http://endpoint/bucket/key) rather than virtual hosted style
+	// (http://bucket.endpoint/key) - this is usually required for S3 compatible stores
+	UsePathStyle *bool `hcl:"use_path_style,optional"`
+
+	// static credentials - if not set, credentials are loaded from the default AWS credential chain
+	AccessKey    *string `hcl:"access_key,optional"`
+	SecretKey    *string `hcl:"secret_key,optional"`
+	SessionToken *string `hcl:"session_token,optional"`
+}
+
+func (c *S3SourceConfig) Validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket is required and cannot be empty")
+	}
+	if c.Endpoint != nil {
+		if err := validateHttpUrl(*c.Endpoint); err != nil {
+			return fmt.Errorf("invalid endpoint: %w", err)
+		}
+	}
+	if (c.AccessKey == nil) != (c.SecretKey == nil) {
+		return fmt.Errorf("access_key and secret_key must be set together")
+	}
+	if c.SessionToken != nil && c.AccessKey == nil {
+		return fmt.Errorf("session_token requires access_key and secret_key to be set")
+	}
+	return c.ArtifactSourceConfigImpl.Validate()
+}
+
+func (c *S3SourceConfig) Identifier() string {
+	return constants.S3SourceIdentifier
+}
+
+// GetPrefix returns the configured prefix, ensuring it ends with a '/' (unless empty)
+func (c *S3SourceConfig) GetPrefix() string {
+	if c.Prefix == nil {
+		return ""
+	}
+	prefix := strings.TrimPrefix(*c.Prefix, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// GetRegion returns the configured region, defaulting to us-east-1
+func (c *S3SourceConfig) GetRegion() string {
+	if c.Region == nil {
+		return defaultS3Region
+	}
+	return *c.Region
+}
+
+// GetEndpoint returns the configured endpoint, defaulting to the AWS S3 endpoint for the region
+func (c *S3SourceConfig) GetEndpoint() *url.URL {
+	if c.Endpoint == nil {
+		return &url.URL{Scheme: "https", Host: fmt.Sprintf("s3.%s.amazonaws.com", c.GetRegion())}
+	}
+	// the endpoint has been validated
+	u, _ := url.Parse(strings.TrimSuffix(*c.Endpoint, "/"))
+	return u
+}
+
+// GetUsePathStyle returns whether path style addressing should be used
+func (c *S3SourceConfig) GetUsePathStyle() bool {
+	return c.UsePathStyle != nil && *c.UsePathStyle
+}
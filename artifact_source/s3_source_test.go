@@ -0,0 +1,272 @@
+package artifact_source
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+)
+
+// fakeS3Server is a minimal in-memory implementation of the S3 ListObjectsV2 and GetObject APIs,
+// using path style addressing
+type fakeS3Server struct {
+	bucket  string
+	objects map[string]string
+	// the maximum number of keys returned by a single list request
+	pageSize int
+
+	mut            sync.Mutex
+	listedPrefixes []string
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`)
+		return
+	}
+	if key != "" {
+		body, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+		_, _ = io.WriteString(w, body)
+		return
+	}
+	f.list(w, r.URL.Query())
+}
+
+func (f *fakeS3Server) list(w http.ResponseWriter, query url.Values) {
+	prefix := query.Get("prefix")
+	f.mut.Lock()
+	f.listedPrefixes = append(f.listedPrefixes, prefix)
+	f.mut.Unlock()
+
+	// build the sorted list of entries (objects and common prefixes) below the prefix
+	// (map of entry to whether it is a common prefix)
+	isPrefix := make(map[string]bool)
+	var entries []string
+	for key := range f.objects {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+		entry := key
+		// keys containing the delimiter (including directory markers) are rolled up into a common prefix
+		i := strings.Index(rest, "/")
+		if i >= 0 {
+			entry = prefix + rest[:i+1]
+		}
+		if _, ok := isPrefix[entry]; !ok {
+			entries = append(entries, entry)
+		}
+		isPrefix[entry] = isPrefix[entry] || i >= 0
+	}
+	sort.Strings(entries)
+
+	// the continuation token is the last entry of the previous page
+	if token := query.Get("continuation-token"); token != "" {
+		i := sort.SearchStrings(entries, token)
+		entries = entries[i+1:]
+	}
+	var res s3ListResult
+	if len(entries) > f.pageSize {
+		entries = entries[:f.pageSize]
+		res.IsTruncated = true
+		res.NextContinuationToken = entries[len(entries)-1]
+	}
+	for _, entry := range entries {
+		if isPrefix[entry] {
+			res.CommonPrefixes = append(res.CommonPrefixes, struct {
+				Prefix string `xml:"Prefix"`
+			}{Prefix: entry})
+		} else {
+			res.Contents = append(res.Contents, s3Object{Key: entry, Size: int64(len(f.objects[entry]))})
+		}
+	}
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"ListBucketResult"`
+		s3ListResult
+	}{s3ListResult: res})
+}
+
+func newTestS3Client(t *testing.T, f *fakeS3Server) *s3Client {
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	config := &S3SourceConfig{
+		Bucket:       f.bucket,
+		Endpoint:     utils.ToPointer(srv.URL),
+		UsePathStyle: utils.ToPointer(true),
+		AccessKey:    utils.ToPointer("AKID"),
+		SecretKey:    utils.ToPointer("SECRET"),
+	}
+	client, err := newS3Client(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func Test_walkS3Prefix(t *testing.T) {
+	f := &fakeS3Server{
+		bucket: "logs",
+		objects: map[string]string{
+			"AWSLogs/111/2024/01/a.json":      "a",
+			"AWSLogs/111/2024/01/b.json":      "b",
+			"AWSLogs/111/2024/02/c.json":      "c",
+			"AWSLogs/111/2024/02/":            "",
+			"AWSLogs/222/2024/01/d.json":      "d",
+			"AWSLogs/readme.txt":              "readme",
+			"other/111/2024/01/ignored.json":  "ignored",
+			"other/222/2024/01/ignored2.json": "ignored",
+		},
+		pageSize: 2,
+	}
+	client := newTestS3Client(t, f)
+
+	layouts := []string{"AWSLogs/%{NUMBER:account_id}/%{YEAR:year}/%{MONTHNUM:month}/%{DATA}.json"}
+	prefix := s3LayoutPrefix(layouts)
+	assert.Equal(t, "AWSLogs/", prefix)
+
+	// prefixes are walked in parallel
+	var mut sync.Mutex
+	var got []string
+	err := walkS3Prefix(context.Background(), client, prefix, func(targetPath string, isDir bool) error {
+		if isDir {
+			if targetPath == "AWSLogs/222" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		mut.Lock()
+		got = append(got, targetPath)
+		mut.Unlock()
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"AWSLogs/readme.txt", "AWSLogs/111/2024/01/a.json", "AWSLogs/111/2024/01/b.json", "AWSLogs/111/2024/02/c.json"}, got)
+
+	// the skipped prefix and the prefixes outside the layout are never listed
+	for _, listed := range f.listedPrefixes {
+		assert.True(t, strings.HasPrefix(listed, "AWSLogs/"), "listed %s", listed)
+		assert.False(t, strings.HasPrefix(listed, "AWSLogs/222/"), "listed %s", listed)
+	}
+}
+
+func Test_s3Client_getObject(t *testing.T) {
+	f := &fakeS3Server{
+		bucket:   "logs",
+		objects:  map[string]string{"dir/file name+1.log": "contents"},
+		pageSize: 1000,
+	}
+	client := newTestS3Client(t, f)
+
+	resp, err := client.getObject(context.Background(), "dir/file name+1.log")
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		assert.Equal(t, "contents", string(body))
+	}
+
+	_, err = client.getObject(context.Background(), "dir/missing.log")
+	var s3Err *s3Error
+	if assert.ErrorAs(t, err, &s3Err) {
+		assert.Equal(t, http.StatusNotFound, s3Err.StatusCode)
+		assert.Equal(t, "NoSuchKey", s3Err.Code)
+	}
+}
+
+func Test_s3LayoutPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		layouts []string
+		want    string
+	}{
+		{
+			name:    "literal leading segments",
+			layouts: []string{"AWSLogs/%{NUMBER:account_id}/CloudTrail/%{DATA}.json.gz"},
+			want:    "AWSLogs/",
+		},
+		{
+			name:    "common prefix of alternatives",
+			layouts: []string{"logs/app/%{YEAR:year}/%{DATA}.log", "logs/web/%{YEAR:year}/%{DATA}.log"},
+			want:    "logs/",
+		},
+		{
+			name:    "regex segment",
+			layouts: []string{"logs/app.*/%{DATA}.log"},
+			want:    "logs/",
+		},
+		{
+			name:    "pattern in first segment",
+			layouts: []string{"%{YEAR:year}/%{DATA}.log"},
+			want:    "",
+		},
+		{
+			name:    "file only",
+			layouts: []string{"app.log"},
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s3LayoutPrefix(tt.layouts))
+		})
+	}
+}
+
+func Test_s3Client_objectUrl(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		pathStyle bool
+		key       string
+		want      string
+	}{
+		{
+			name:      "path style",
+			endpoint:  "http://localhost:9000",
+			pathStyle: true,
+			key:       "a/b c.log",
+			want:      "http://localhost:9000/bucket/a/b%20c.log",
+		},
+		{
+			name:     "virtual hosted style",
+			endpoint: "https://s3.eu-west-1.amazonaws.com",
+			key:      "a/b+c.log",
+			want:     "https://bucket.s3.eu-west-1.amazonaws.com/a/b%2Bc.log",
+		},
+		{
+			name:      "bucket",
+			endpoint:  "http://localhost:9000/s3/",
+			pathStyle: true,
+			want:      "http://localhost:9000/s3/bucket/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &S3SourceConfig{Bucket: "bucket", Endpoint: utils.ToPointer(tt.endpoint)}
+			c := &s3Client{endpoint: config.GetEndpoint(), bucket: config.Bucket, pathStyle: tt.pathStyle}
+			assert.Equal(t, tt.want, c.objectUrl(tt.key).String())
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package artifact_source
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const defaultSftpPort = 22
+
+// SftpConnection is the connection used by the SftpSource
+//
+// The server host key is always verified, either against a known_hosts file (by default ~/.ssh/known_hosts)
+// or against an explicitly configured host key.
+type SftpConnection struct {
+	Host string `hcl:"host"`
+	// the port of the SSH server (defaults to 22)
+	Port *int   `hcl:"port,optional"`
+	User string `hcl:"user"`
+
+	// password authentication
+	Password *string `hcl:"password,optional"`
+	// public key authentication - the private key may be given either inline or as a path
+	PrivateKey     *string `hcl:"private_key,optional"`
+	PrivateKeyPath *string `hcl:"private_key_path,optional"`
+	// the passphrase of the private key, if it is encrypted
+	PrivateKeyPassphrase *string `hcl:"private_key_passphrase,optional"`
+
+	// the known_hosts file used to verify the server host key (defaults to ~/.ssh/known_hosts)
+	KnownHostsPath *string `hcl:"known_hosts_path,optional"`
+	// the expected server host key, in authorized_keys format (e.g. "ssh-ed25519 AAAA...")
+	// if set, this is used instead of the known_hosts file
+	HostKey *string `hcl:"host_key,optional"`
+}
+
+func (c *SftpConnection) Validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	if c.User == "" {
+		return fmt.Errorf("user is required")
+	}
+	if c.Port != nil && (*c.Port <= 0 || *c.Port > 65535) {
+		return fmt.Errorf("invalid port %d", *c.Port)
+	}
+	if c.Password == nil && c.PrivateKey == nil && c.PrivateKeyPath == nil {
+		return fmt.Errorf("one of password, private_key or private_key_path must be set")
+	}
+	if c.PrivateKey != nil && c.PrivateKeyPath != nil {
+		return fmt.Errorf("only one of private_key and private_key_path may be set")
+	}
+	if c.HostKey != nil && c.KnownHostsPath != nil {
+		return fmt.Errorf("only one of host_key and known_hosts_path may be set")
+	}
+	if c.HostKey != nil {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(*c.HostKey)); err != nil {
+			return fmt.Errorf("invalid host_key: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *SftpConnection) Identifier() string {
+	return constants.SftpSourceIdentifier
+}
+
+// GetAddress returns the host:port address of the SSH server
+func (c *SftpConnection) GetAddress() string {
+	port := defaultSftpPort
+	if c.Port != nil {
+		port = *c.Port
+	}
+	return net.JoinHostPort(c.Host, strconv.Itoa(port))
+}
+
+// sshClientConfig builds the SSH client config, loading the private key and host key verification data
+func (c *SftpConnection) sshClientConfig() (*ssh.ClientConfig, error) {
+	var authMethods []ssh.AuthMethod
+	if c.PrivateKey != nil || c.PrivateKeyPath != nil {
+		signer, err := c.privateKeySigner()
+		if err != nil {
+			return nil, err
+		}
+		authMethods = append(authMethods, ssh.PublicKeys(signer))
+	}
+	if c.Password != nil {
+		authMethods = append(authMethods, ssh.Password(*c.Password))
+	}
+
+	hostKeyCallback, err := c.hostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssh.ClientConfig{
+		User:            c.User,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+	}, nil
+}
+
+func (c *SftpConnection) privateKeySigner() (ssh.Signer, error) {
+	var keyBytes []byte
+	if c.PrivateKey != nil {
+		keyBytes = []byte(*c.PrivateKey)
+	} else {
+		var err error
+		keyBytes, err = os.ReadFile(*c.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading private key: %w", err)
+		}
+	}
+
+	var signer ssh.Signer
+	var err error
+	if c.PrivateKeyPassphrase != nil {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(keyBytes, []byte(*c.PrivateKeyPassphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey(keyBytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %w", err)
+	}
+	return signer, nil
+}
+
+func (c *SftpConnection) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if c.HostKey != nil {
+		// the host key has been validated
+		key, _, _, _, _ := ssh.ParseAuthorizedKey([]byte(*c.HostKey))
+		return ssh.FixedHostKey(key), nil
+	}
+
+	var knownHostsPath string
+	if c.KnownHostsPath != nil {
+		knownHostsPath = *c.KnownHostsPath
+	} else {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("error determining the default known_hosts path: %w", err)
+		}
+		knownHostsPath = filepath.Join(home, ".ssh", "known_hosts")
+	}
+	callback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading known_hosts file: %w", err)
+	}
+	return callback, nil
+}
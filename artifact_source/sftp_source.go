@@ -0,0 +1,330 @@
+package artifact_source
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/sftp"
+	"github.com/turbot/pipe-fittings/v2/filter"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/rate_limiter"
+	"github.com/turbot/tailpipe-plugin-sdk/row_source"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+	"golang.org/x/crypto/ssh"
+)
+
+// the suffix of a download which has not yet completed
+const sftpPartialSuffix = ".partial"
+
+// SftpSource is an [ArtifactSource] implementation which collects artifacts from an SFTP server
+//
+// Remote directories are walked in the same way as local directories, applying the file layout and filters.
+// Files are downloaded to the temp directory - if a download is interrupted, it is resumed from where it stopped,
+// both when the download is retried (after reconnecting) and across collections (as long as the remote file is unchanged).
+//
+// NOTE: the source is not registered automatically - plugins which want to provide it should register it
+// from their package init function:
+//
+//	row_source.RegisterRowSource[*artifact_source.SftpSource]()
+type SftpSource struct {
+	ArtifactSourceImpl[*SftpSourceConfig, *SftpConnection]
+
+	// the SFTP client is created on first use and shared by all downloads
+	clientLock sync.Mutex
+	sshClient  *ssh.Client
+	client     *sftp.Client
+}
+
+func (s *SftpSource) Init(ctx context.Context, params *row_source.RowSourceParams, opts ...row_source.RowSourceOption) error {
+	// call base to parse config and apply options
+	if err := s.ArtifactSourceImpl.Init(ctx, params, opts...); err != nil {
+		return err
+	}
+
+	// build the SSH config now, so any problem with the keys is reported before we start collecting
+	if _, err := s.Connection.sshClientConfig(); err != nil {
+		return err
+	}
+
+	slog.Info("Initialized SftpSource", "address", s.Connection.GetAddress(), "user", s.Connection.User, "paths", s.Config.Paths, "layouts", s.Config.GetFileLayouts())
+	return nil
+}
+
+func (s *SftpSource) Identifier() string {
+	return constants.SftpSourceIdentifier
+}
+
+func (s *SftpSource) Description() (string, error) {
+	// the connection is not set if the source has not been initialised
+	if s.Connection == nil {
+		return "Collect artifacts from an SFTP server.", nil
+	}
+	return fmt.Sprintf("Collect artifacts from SFTP server %s.", s.Connection.GetAddress()) + s.describeDownloadLimits(), nil
+}
+
+// Collect collects the artifacts, then closes the connection to the server
+func (s *SftpSource) Collect(ctx context.Context) error {
+	defer s.closeClient()
+	return s.ArtifactSourceImpl.Collect(ctx)
+}
+
+func (s *SftpSource) Close() error {
+	s.closeClient()
+	return nil
+}
+
+// DiscoverArtifacts walks each of the configured remote paths, calling WalkNode for every file and directory
+func (s *SftpSource) DiscoverArtifacts(ctx context.Context) error {
+	client, err := s.getClient()
+	if err != nil {
+		return err
+	}
+	layouts, g, err := newLayoutMatcher(s.Config.GetFileLayouts(), s.Config.GetPatterns())
+	if err != nil {
+		return err
+	}
+	filterMap := make(map[string]*filter.SqlFilter)
+
+	visit := func(targetPath, basePath string, isDir bool) error {
+		return s.WalkNode(ctx, targetPath, basePath, layouts, isDir, g, filterMap)
+	}
+	for _, p := range s.Config.Paths {
+		if err := walkSftpPath(ctx, client, path.Clean(p), visit); err != nil {
+			return fmt.Errorf("error walking remote path %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// DownloadArtifact downloads the remote file to the temp directory, resuming any partial download
+func (s *SftpSource) DownloadArtifact(ctx context.Context, info *types.ArtifactInfo) error {
+	client, err := s.getClient()
+	if err != nil {
+		return err
+	}
+	stat, err := client.Stat(info.Name)
+	if err != nil {
+		return fmt.Errorf("error getting file info for %s: %w", info.Name, err)
+	}
+
+	if err := os.MkdirAll(s.TempDir, 0755); err != nil {
+		return fmt.Errorf("error creating temp directory %s: %w", s.TempDir, err)
+	}
+	localPath := filepath.Join(s.TempDir, sftpDownloadName(info.Name, stat))
+	partialPath := localPath + sftpPartialSuffix
+
+	if err := downloadSftpFile(ctx, client, info.Name, partialPath, s.artifactBandwidthLimiter); err != nil {
+		var statusErr *sftp.StatusError
+		if errors.As(err, &statusErr) || ctx.Err() != nil {
+			return fmt.Errorf("error downloading %s: %w", info.Name, err)
+		}
+		// the connection may have been lost - discard the client so the retry reconnects
+		// (the partial download is kept, so the retry resumes from where this attempt stopped)
+		s.resetClient(client)
+		return &RetryableError{Err: fmt.Errorf("error downloading %s: %w", info.Name, err)}
+	}
+
+	if err := os.Rename(partialPath, localPath); err != nil {
+		return fmt.Errorf("error renaming downloaded file for %s: %w", info.Name, err)
+	}
+	localStat, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	downloadInfo := types.NewDownloadedArtifactInfo(info, localPath, localStat.Size())
+	downloadInfo.Version = &types.ArtifactVersion{Size: localStat.Size(), ModTime: stat.ModTime()}
+	return s.OnArtifactDownloaded(ctx, downloadInfo)
+}
+
+// GetArtifactVersion implements ArtifactVersioner
+func (s *SftpSource) GetArtifactVersion(_ context.Context, name string) (*types.ArtifactVersion, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	stat, err := client.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ArtifactVersion{Size: stat.Size(), ModTime: stat.ModTime()}, nil
+}
+
+// OpenDigestFile implements DigestFileSource
+func (s *SftpSource) OpenDigestFile(_ context.Context, name string) (io.ReadCloser, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.Open(name)
+}
+
+// getClient returns the SFTP client, connecting to the server if necessary
+func (s *SftpSource) getClient() (*sftp.Client, error) {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+	sshClient, client, err := dialSftp(s.Connection)
+	if err != nil {
+		return nil, err
+	}
+	s.sshClient = sshClient
+	s.client = client
+	return client, nil
+}
+
+// resetClient closes the given client, if it is still the current client, so the next call to getClient reconnects
+// (another download may already have reconnected)
+func (s *SftpSource) resetClient(client *sftp.Client) {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+
+	if s.client == client {
+		s.closeClientLocked()
+	}
+}
+
+func (s *SftpSource) closeClient() {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+
+	s.closeClientLocked()
+}
+
+func (s *SftpSource) closeClientLocked() {
+	if s.client != nil {
+		_ = s.client.Close()
+		s.client = nil
+	}
+	if s.sshClient != nil {
+		_ = s.sshClient.Close()
+		s.sshClient = nil
+	}
+}
+
+// dialSftp connects to the SSH server and starts an SFTP session
+func dialSftp(conn *SftpConnection) (*ssh.Client, *sftp.Client, error) {
+	config, err := conn.sshClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	sshClient, err := ssh.Dial("tcp", conn.GetAddress(), config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to %s: %w", conn.GetAddress(), err)
+	}
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		_ = sshClient.Close()
+		return nil, nil, fmt.Errorf("error starting SFTP session with %s: %w", conn.GetAddress(), err)
+	}
+	return sshClient, client, nil
+}
+
+// walkSftpPath walks the given remote path, calling visit for each regular file and directory below it
+// - if the path is a file, visit is called for the file only, with the parent directory as the base path
+// - if visit returns fs.SkipDir for a directory, the directory is not descended into
+// directories are listed in parallel (see WalkParallel), so visit may be called concurrently
+func walkSftpPath(ctx context.Context, client *sftp.Client, root string, visit func(targetPath, basePath string, isDir bool) error) error {
+	stat, err := client.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !stat.IsDir() {
+		return visit(root, path.Dir(root), false)
+	}
+
+	list := func(_ context.Context, dir string) ([]DirEntry, error) {
+		infos, err := client.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]DirEntry, 0, len(infos))
+		for _, info := range infos {
+			isDir := info.IsDir()
+			if !isDir && !info.Mode().IsRegular() {
+				// skip symlinks, devices etc.
+				continue
+			}
+			entries = append(entries, DirEntry{Path: path.Join(dir, info.Name()), IsDir: isDir})
+		}
+		return entries, nil
+	}
+	return WalkParallel(ctx, root, DefaultWalkConcurrency, list, func(targetPath string, isDir bool) error {
+		return visit(targetPath, root, isDir)
+	})
+}
+
+// downloadSftpFile downloads the remote file to the given local path, appending to the local file if it exists
+// (i.e. resuming a partial download)
+// if a bandwidth limiter is passed, the throughput of the download is limited
+func downloadSftpFile(ctx context.Context, client *sftp.Client, remotePath, localPath string, bandwidthLimiter *rate_limiter.APILimiter) error {
+	remote, err := client.Open(remotePath)
+	if err != nil {
+		return err
+	}
+	defer remote.Close()
+	// close the remote file if the context is cancelled, to abort the copy
+	stop := context.AfterFunc(ctx, func() { _ = remote.Close() })
+	defer stop()
+
+	stat, err := remote.Stat()
+	if err != nil {
+		return err
+	}
+
+	local, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	offset, err := local.Seek(0, io.SeekEnd)
+	if err != nil {
+		_ = local.Close()
+		return err
+	}
+	// if the partial download is larger than the remote file, it cannot be resumed - start again
+	if offset > stat.Size() {
+		if err := local.Truncate(0); err != nil {
+			_ = local.Close()
+			return err
+		}
+		if offset, err = local.Seek(0, io.SeekStart); err != nil {
+			_ = local.Close()
+			return err
+		}
+	}
+	if offset > 0 {
+		slog.Debug("Resuming SFTP download", "path", remotePath, "offset", offset)
+		if _, err := remote.Seek(offset, io.SeekStart); err != nil {
+			_ = local.Close()
+			return err
+		}
+	}
+
+	_, err = io.Copy(local, rate_limiter.NewLimitedReader(ctx, remote, bandwidthLimiter))
+	closeErr := local.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// sftpDownloadName returns the local file name for a remote file
+// the name is derived from the remote path, size and modification time, so a partial download is only resumed
+// if the remote file has not changed
+// (the remote file name is kept as a suffix so the loader is chosen based on the original extension)
+func sftpDownloadName(remotePath string, info os.FileInfo) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d", remotePath, info.Size(), info.ModTime().UnixNano())))
+	return fmt.Sprintf("%x-%s", h[:8], strings.TrimSuffix(path.Base(remotePath), sftpPartialSuffix))
+}
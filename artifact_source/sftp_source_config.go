@@ -0,0 +1,36 @@
+package artifact_source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/constants"
+)
+
+// SftpSourceConfig is the config for the SftpSource
+type SftpSourceConfig struct {
+	artifact_source_config.ArtifactSourceConfigImpl
+	// required to allow partial decoding
+	Remain hcl.Body `hcl:",remain" json:"-"`
+
+	// the remote paths to collect from - each may be a directory or a single file
+	Paths []string `hcl:"paths"`
+}
+
+func (c *SftpSourceConfig) Validate() error {
+	if len(c.Paths) == 0 {
+		return fmt.Errorf("paths is required and cannot be empty")
+	}
+	for _, p := range c.Paths {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("paths cannot contain an empty path")
+		}
+	}
+	return c.ArtifactSourceConfigImpl.Validate()
+}
+
+func (c *SftpSourceConfig) Identifier() string {
+	return constants.SftpSourceIdentifier
+}
@@ -0,0 +1,310 @@
+package artifact_source
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// testSftpServer is an in-process SSH server which serves the local file system over SFTP
+type testSftpServer struct {
+	host, port string
+	hostKey    ssh.PublicKey
+	// the private key (PEM) accepted for public key authentication
+	clientKey string
+}
+
+const (
+	testSftpUser     = "tailpipe"
+	testSftpPassword = "secret"
+)
+
+func newTestSftpServer(t *testing.T) *testSftpServer {
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPem, err := ssh.MarshalPrivateKey(clientPriv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorizedKey, err := ssh.NewPublicKey(clientPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if c.User() == testSftpUser && string(password) == testSftpPassword {
+				return nil, nil
+			}
+			return nil, os.ErrPermission
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if c.User() == testSftpUser && string(key.Marshal()) == string(authorizedKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, os.ErrPermission
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSftpConn(conn, config)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return &testSftpServer{
+		host:      host,
+		port:      port,
+		hostKey:   hostSigner.PublicKey(),
+		clientKey: string(pem.EncodeToMemory(clientPem)),
+	}
+}
+
+func serveTestSftpConn(conn net.Conn, config *ssh.ServerConfig) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range channelRequests {
+				// the payload of a subsystem request is the length-prefixed subsystem name
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				_ = req.Reply(ok, nil)
+				if ok {
+					server, err := sftp.NewServer(channel)
+					if err != nil {
+						return
+					}
+					_ = server.Serve()
+					_ = channel.Close()
+				}
+			}
+		}()
+	}
+}
+
+// connection returns a connection to the server, verifying the host key using a known_hosts file
+func (s *testSftpServer) connection(t *testing.T) *SftpConnection {
+	knownHostsPath := filepath.Join(t.TempDir(), "known_hosts")
+	line := knownhosts.Line([]string{net.JoinHostPort(s.host, s.port)}, s.hostKey)
+	if err := os.WriteFile(knownHostsPath, []byte(line+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	port, _ := strconv.Atoi(s.port)
+	return &SftpConnection{
+		Host:           s.host,
+		Port:           &port,
+		User:           testSftpUser,
+		Password:       utils.ToPointer(testSftpPassword),
+		KnownHostsPath: &knownHostsPath,
+	}
+}
+
+func Test_dialSftp(t *testing.T) {
+	server := newTestSftpServer(t)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherSigner, _ := ssh.NewSignerFromKey(otherKey)
+
+	tests := []struct {
+		name    string
+		modify  func(c *SftpConnection)
+		wantErr bool
+	}{
+		{
+			name:   "password with known_hosts",
+			modify: func(c *SftpConnection) {},
+		},
+		{
+			name: "private key",
+			modify: func(c *SftpConnection) {
+				c.Password = nil
+				c.PrivateKey = utils.ToPointer(server.clientKey)
+			},
+		},
+		{
+			name: "wrong password",
+			modify: func(c *SftpConnection) {
+				c.Password = utils.ToPointer("wrong")
+			},
+			wantErr: true,
+		},
+		{
+			name: "host key",
+			modify: func(c *SftpConnection) {
+				c.KnownHostsPath = nil
+				c.HostKey = utils.ToPointer(string(ssh.MarshalAuthorizedKey(server.hostKey)))
+			},
+		},
+		{
+			name: "host key mismatch",
+			modify: func(c *SftpConnection) {
+				c.KnownHostsPath = nil
+				c.HostKey = utils.ToPointer(string(ssh.MarshalAuthorizedKey(otherSigner.PublicKey())))
+			},
+			wantErr: true,
+		},
+		{
+			name: "host not in known_hosts",
+			modify: func(c *SftpConnection) {
+				emptyPath := filepath.Join(t.TempDir(), "known_hosts")
+				_ = os.WriteFile(emptyPath, nil, 0600)
+				c.KnownHostsPath = &emptyPath
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := server.connection(t)
+			tt.modify(conn)
+			if err := conn.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			sshClient, client, err := dialSftp(conn)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				_ = client.Close()
+				_ = sshClient.Close()
+			}
+		})
+	}
+}
+
+func Test_walkSftpPath(t *testing.T) {
+	server := newTestSftpServer(t)
+	sshClient, client, err := dialSftp(server.connection(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sshClient.Close()
+	defer client.Close()
+
+	root := t.TempDir()
+	for _, p := range []string{"2024/01/a.log", "2024/02/b.log", "skip/c.log"} {
+		writeTestFile(t, filepath.Join(root, p), "data")
+	}
+
+	// directories are walked in parallel
+	var mut sync.Mutex
+	var got []string
+	err = walkSftpPath(context.Background(), client, root, func(targetPath, basePath string, isDir bool) error {
+		assert.Equal(t, root, basePath)
+		rel, _ := filepath.Rel(basePath, targetPath)
+		mut.Lock()
+		defer mut.Unlock()
+		if isDir {
+			got = append(got, rel+"/")
+			if rel == "skip" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		got = append(got, rel)
+		return nil
+	})
+	assert.NoError(t, err)
+	// the server does not sort directory entries
+	assert.ElementsMatch(t, []string{"2024/", "2024/01/", "2024/01/a.log", "2024/02/", "2024/02/b.log", "skip/"}, got)
+
+	// a single file is visited with its parent directory as the base path
+	err = walkSftpPath(context.Background(), client, filepath.Join(root, "skip/c.log"), func(targetPath, basePath string, isDir bool) error {
+		assert.Equal(t, filepath.Join(root, "skip"), basePath)
+		assert.False(t, isDir)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func Test_downloadSftpFile(t *testing.T) {
+	server := newTestSftpServer(t)
+	sshClient, client, err := dialSftp(server.connection(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sshClient.Close()
+	defer client.Close()
+
+	dir := t.TempDir()
+	remotePath := filepath.Join(dir, "remote.log")
+	writeTestFile(t, remotePath, "0123456789")
+
+	tests := []struct {
+		name    string
+		partial *string
+		want    string
+	}{
+		{name: "new download", want: "0123456789"},
+		// the partial data differs from the remote file, to show only the remaining data is downloaded
+		{name: "resume partial download", partial: utils.ToPointer("abcd"), want: "abcd456789"},
+		{name: "partial larger than remote", partial: utils.ToPointer("abcdefghijkl"), want: "0123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localPath := filepath.Join(t.TempDir(), "local.log"+sftpPartialSuffix)
+			if tt.partial != nil {
+				writeTestFile(t, localPath, *tt.partial)
+			}
+			assert.NoError(t, downloadSftpFile(context.Background(), client, remotePath, localPath, nil))
+			got, err := os.ReadFile(localPath)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
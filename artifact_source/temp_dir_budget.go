@@ -0,0 +1,79 @@
+package artifact_source
+
+import (
+	"context"
+	"sync"
+)
+
+// tempDirBudget tracks the disk space used by downloaded artifacts in the temp directory which are waiting to be
+// (or are being) extracted, and holds back new downloads while the usage exceeds the budget
+//
+// NOTE: the size of an artifact is only known once it has been downloaded, so the budget is a soft limit -
+// downloads which are already in progress when the budget is reached will still complete
+type tempDirBudget struct {
+	// the budget in bytes - zero means no budget
+	maxBytes int64
+
+	mut   sync.Mutex
+	usage int64
+	// closed (and replaced) whenever usage is released, to wake waiting downloads
+	released chan struct{}
+}
+
+func newTempDirBudget(maxBytes int64) *tempDirBudget {
+	return &tempDirBudget{
+		maxBytes: maxBytes,
+		released: make(chan struct{}),
+	}
+}
+
+// wait blocks until the usage is below the budget, or the context is cancelled
+// (a nil budget never blocks, as the source may not have been initialised, e.g. PluginSourceWrapper)
+func (b *tempDirBudget) wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	for {
+		b.mut.Lock()
+		if b.maxBytes <= 0 || b.usage < b.maxBytes {
+			b.mut.Unlock()
+			return nil
+		}
+		released := b.released
+		b.mut.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// add records a downloaded artifact of the given size, returning the new usage
+func (b *tempDirBudget) add(size int64) int64 {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.usage += size
+	return b.usage
+}
+
+// release records that an artifact of the given size has been removed, returning the new usage
+func (b *tempDirBudget) release(size int64) int64 {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.usage -= size
+	close(b.released)
+	b.released = make(chan struct{})
+	return b.usage
+}
+
+// getUsage returns the disk space currently used by downloaded artifacts
+func (b *tempDirBudget) getUsage() int64 {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	return b.usage
+}
@@ -0,0 +1,44 @@
+package artifact_source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTempDirBudget_wait(t *testing.T) {
+	b := newTempDirBudget(100)
+	assert.NoError(t, b.wait(context.Background()))
+
+	// over budget - wait must block until usage is released
+	assert.Equal(t, int64(150), b.add(150))
+	done := make(chan error)
+	go func() {
+		done <- b.wait(context.Background())
+	}()
+	select {
+	case <-done:
+		t.Fatal("wait returned while over budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, int64(50), b.release(100))
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("wait did not return after usage was released")
+	}
+
+	// cancelling the context stops the wait
+	b.add(100)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, b.wait(ctx), context.Canceled)
+
+	// no budget never blocks
+	unlimited := newTempDirBudget(0)
+	unlimited.add(1000)
+	assert.NoError(t, unlimited.wait(context.Background()))
+}
@@ -1,10 +1,22 @@
 package artifact_source_config
 
-import "github.com/turbot/tailpipe-plugin-sdk/parse"
+import (
+	"github.com/turbot/pipe-fittings/v2/filter"
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+)
 
 type ArtifactSourceConfig interface {
 	parse.Config
 
+	// GetFileLayout returns the first file layout (retained for compatibility - prefer GetFileLayouts)
 	GetFileLayout() *string
+	GetFileLayouts() []string
+	GetPatterns() map[string]string
+	GetFilterMap() map[string]*filter.SqlFilter
+	GetExclude() []string
+	GetChecksumConfig() *ChecksumConfig
+	GetChangePolicy() ChangePolicy
+	GetTempDirMaxSize() int64
+	GetLimiterConfig() *LimiterConfig
 	DefaultTo(ArtifactSourceConfig)
 }
@@ -1,48 +1,99 @@
 package artifact_source_config
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/turbot/go-kit/helpers"
-	typehelpers "github.com/turbot/go-kit/types"
+	"github.com/turbot/pipe-fittings/v2/filter"
 	"github.com/turbot/pipe-fittings/v2/utils"
 	"github.com/turbot/tailpipe-plugin-sdk/grpc/proto"
+	helpers2 "github.com/turbot/tailpipe-plugin-sdk/helpers"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
 )
 
 type ArtifactSourceConfigImpl struct {
 	// required to allow partial decoding
 	Remain hcl.Body `hcl:",remain" json:"-"`
 
-	// grok string defining the file layout and allowing metadata to be extracted
-	FileLayout *string `hcl:"file_layout,optional"`
+	// file_layout is either a single grok string defining the file layout and allowing metadata to be extracted,
+	// or a list of them, which are tried in order (e.g. for a bucket whose naming convention has changed over time)
+	// this is resolved into FileLayouts (and FileLayout) once the config has been decoded
+	FileLayoutExpr hcl.Expression `hcl:"file_layout,optional" json:"-"`
+	// the grok strings defining the file layouts, in the order they are tried
+	FileLayouts []string `json:"file_layouts,omitempty"`
+	// the first file layout - this may be set instead of FileLayouts (e.g. in default config)
+	FileLayout *string `json:"file_layout,omitempty"`
 
 	// grok patterns to add to the grok parser used to parse the layout
 	Patterns map[string]string `hcl:"patterns,optional"`
+
+	// filters on the fields of the file layout, e.g. "region in ('us-east-1', 'us-west-2')"
+	// each filter must refer to a single field, which must be in every file layout
+	// - paths whose metadata does not satisfy the filters are skipped
+	// (if there are multiple filters for a field, all must be satisfied)
+	Filters []string `hcl:"filter,optional"`
+	// glob patterns (which may contain '**') matching paths to exclude, relative to the base path of the source,
+	// e.g. "**/*.tmp" - excluded directories are not walked
+	Exclude []string `hcl:"exclude,optional"`
+	// the parsed filters, keyed by the field they refer to - this is populated by Validate
+	FilterMap map[string]*filter.SqlFilter `json:"-"`
+
+	// if set, downloaded artifacts are verified against digest files stored alongside them
+	Checksum *ChecksumConfig `hcl:"checksum,block"`
+
+	// how artifacts which have changed since they were collected are handled - one of ignore (the default),
+	// recollect or append
+	OnChange *string `hcl:"on_change,optional"`
+
+	// the maximum disk space used by downloaded artifacts waiting to be extracted (e.g. "10GB")
+	// when this is exceeded, further downloads wait until artifacts have been extracted and removed
+	TempDirMaxSize *string `hcl:"temp_dir_max_size,optional"`
+
+	// if set, overrides the default download concurrency and (optionally) limits the download rate
+	Limiter *LimiterConfig `hcl:"limiter,block"`
 }
 
 func (b *ArtifactSourceConfigImpl) Validate() error {
-	// parse filters and put into map keyed by property name
-	//filterMap, err := helpers2.BuildFilterMap(b.Filters)
-	//if err != nil {
-	//	return err
-	//}
-	//b.FilterMap = filterMap
-	//
-	//// validate the filters - if filters are set, file layout must be set
-	//if len(b.Filters) > 0 {
-	//	if b.FileLayout == nil {
-	//		return fmt.Errorf("filters are set, but file_layout is not set")
-	//	}
-	//
-	//	// validate all fields referred to in the filters exist in the filter layout
-	//	metadataProperties := helpers.SliceToLookup(helpers2.ExtractNamedGroupsFromGrok(*b.FileLayout))
-	//	// we have already pulled out the property names in the map keys
-	//	for k := range b.FilterMap {
-	//		if _, ok := metadataProperties[k]; !ok {
-	//			return fmt.Errorf("filter %s refers to a property not in the file layout", k)
-	//		}
-	//	}
-	//}
-	//
+	if err := b.validateFilters(); err != nil {
+		return err
+	}
+	for _, pattern := range b.Exclude {
+		// validate with the same glob syntax used to match paths
+		if !doublestar.ValidatePattern(pattern) {
+			return fmt.Errorf("invalid exclude pattern '%s'", pattern)
+		}
+	}
+	if b.Checksum != nil {
+		if err := b.Checksum.Validate(); err != nil {
+			return fmt.Errorf("invalid checksum config: %w", err)
+		}
+	}
+	if b.OnChange != nil {
+		if err := ChangePolicy(*b.OnChange).Validate(); err != nil {
+			return err
+		}
+		// NOTE: if the file layout is not set, this is validated once the source has applied its default config
+		if err := ValidateChangePolicyFileLayouts(b.GetChangePolicy(), b.GetFileLayouts()); err != nil {
+			return err
+		}
+	}
+	if b.TempDirMaxSize != nil {
+		if _, err := humanize.ParseBytes(*b.TempDirMaxSize); err != nil {
+			return fmt.Errorf("invalid temp_dir_max_size '%s': %w", *b.TempDirMaxSize, err)
+		}
+	}
+	if b.Limiter != nil {
+		if err := b.Limiter.Validate(); err != nil {
+			return fmt.Errorf("invalid limiter config: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -50,36 +101,192 @@ func (b *ArtifactSourceConfigImpl) Identifier() string {
 	return "artifact_source"
 }
 
+// ResolveDecoded populates FileLayouts from the file_layout attribute, which may be a single layout
+// or a list of layouts
+// Implements [parse.DecodeResolver] - this is called once the config has been decoded, before Validate
+func (b *ArtifactSourceConfigImpl) ResolveDecoded() error {
+	return b.resolveFileLayouts()
+}
+
+// resolveFileLayouts populates FileLayouts from the file_layout attribute, which may be a single layout
+// or a list of layouts
+func (b *ArtifactSourceConfigImpl) resolveFileLayouts() error {
+	if b.FileLayoutExpr == nil {
+		return nil
+	}
+	val, diags := b.FileLayoutExpr.Value(nil)
+	if diags.HasErrors() {
+		return fmt.Errorf("invalid file_layout: %s", diags.Error())
+	}
+	if val.IsNull() {
+		return nil
+	}
+
+	if val.Type() == cty.String {
+		b.setFileLayouts([]string{val.AsString()})
+		return nil
+	}
+	if !val.Type().IsListType() && !val.Type().IsTupleType() {
+		return fmt.Errorf("file_layout must be a string or a list of strings")
+	}
+	listVal, err := convert.Convert(val, cty.List(cty.String))
+	if err != nil {
+		return fmt.Errorf("file_layout must be a string or a list of strings: %w", err)
+	}
+	var layouts []string
+	if err := gocty.FromCtyValue(listVal, &layouts); err != nil {
+		return fmt.Errorf("invalid file_layout: %w", err)
+	}
+	if len(layouts) == 0 {
+		return fmt.Errorf("file_layout must contain at least one layout")
+	}
+	b.setFileLayouts(layouts)
+	return nil
+}
+
+// setFileLayouts sets FileLayouts, and sets FileLayout to the first layout for code which reads it directly
+func (b *ArtifactSourceConfigImpl) setFileLayouts(layouts []string) {
+	b.FileLayouts = layouts
+	b.FileLayout = nil
+	if len(layouts) > 0 {
+		b.FileLayout = utils.ToPointer(layouts[0])
+	}
+}
+
+// validateFilters parses the filters into FilterMap and, if the file layout is set, validates that they refer to
+// fields of the layout
+// (if the file layout is not set, the fields are validated once the source has applied its default config)
+func (b *ArtifactSourceConfigImpl) validateFilters() error {
+	if len(b.Filters) == 0 {
+		return nil
+	}
+	filterMap, err := helpers2.BuildFilterMap(b.Filters)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	b.FilterMap = filterMap
+
+	if fileLayouts := b.GetFileLayouts(); len(fileLayouts) > 0 {
+		return ValidateFilterFields(b.FilterMap, fileLayouts)
+	}
+	return nil
+}
+
+// ValidateFilterFields returns an error if any of the filters refers to a field which is not in every one of the
+// file layouts
+// (a path which matches a layout without the field could not be filtered, so would always be collected)
+func ValidateFilterFields(filterMap map[string]*filter.SqlFilter, fileLayouts []string) error {
+	if len(filterMap) == 0 {
+		return nil
+	}
+	if len(fileLayouts) == 0 {
+		return fmt.Errorf("filters are set, but file_layout is not set")
+	}
+
+	for _, fileLayout := range fileLayouts {
+		metadataProperties := helpers.SliceToLookup(helpers2.ExtractNamedGroupsFromGrok(fileLayout))
+		for _, k := range slices.Sorted(maps.Keys(filterMap)) {
+			if _, ok := metadataProperties[k]; !ok {
+				if len(fileLayouts) == 1 {
+					return fmt.Errorf("filter on '%s' refers to a property not in the file layout", k)
+				}
+				return fmt.Errorf("filter on '%s' refers to a property not in file layout '%s' - filters must refer to properties of every file layout", k, fileLayout)
+			}
+		}
+	}
+	return nil
+}
+
+// GetFileLayouts returns the file layouts, in the order they should be tried, or nil if no file layout is set
+func (b *ArtifactSourceConfigImpl) GetFileLayouts() []string {
+	if len(b.FileLayouts) > 0 {
+		return b.FileLayouts
+	}
+	if b.FileLayout != nil {
+		return []string{*b.FileLayout}
+	}
+	return nil
+}
+
+// GetFileLayout returns the first file layout, or nil if no file layout is set
+// this is retained for compatibility - GetFileLayouts returns all of the file layouts
 func (b *ArtifactSourceConfigImpl) GetFileLayout() *string {
-	return b.FileLayout
+	if fileLayouts := b.GetFileLayouts(); len(fileLayouts) > 0 {
+		return &fileLayouts[0]
+	}
+	return nil
 }
 
 func (b *ArtifactSourceConfigImpl) GetPatterns() map[string]string {
 	return b.Patterns
 }
 
+func (b *ArtifactSourceConfigImpl) GetFilterMap() map[string]*filter.SqlFilter {
+	return b.FilterMap
+}
+
+func (b *ArtifactSourceConfigImpl) GetExclude() []string {
+	return b.Exclude
+}
+
+func (b *ArtifactSourceConfigImpl) GetChecksumConfig() *ChecksumConfig {
+	return b.Checksum
+}
+
+// GetChangePolicy returns the change policy, or ChangePolicyIgnore if not set
+func (b *ArtifactSourceConfigImpl) GetChangePolicy() ChangePolicy {
+	if b.OnChange == nil {
+		return ChangePolicyIgnore
+	}
+	return ChangePolicy(*b.OnChange)
+}
+
+// GetTempDirMaxSize returns the temp directory disk budget in bytes, or zero if there is no budget
+func (b *ArtifactSourceConfigImpl) GetTempDirMaxSize() int64 {
+	if b.TempDirMaxSize == nil {
+		return 0
+	}
+	// the value has already been validated
+	size, _ := humanize.ParseBytes(*b.TempDirMaxSize)
+	return int64(size)
+}
+
+func (b *ArtifactSourceConfigImpl) GetLimiterConfig() *LimiterConfig {
+	return b.Limiter
+}
+
 func (b *ArtifactSourceConfigImpl) DefaultTo(other ArtifactSourceConfig) {
 	if helpers.IsNil(other) {
 		return
 	}
 
-	if other.GetFileLayout() != nil && b.FileLayout == nil {
-		b.FileLayout = other.GetFileLayout()
+	if len(b.GetFileLayouts()) == 0 {
+		b.setFileLayouts(other.GetFileLayouts())
 	}
 }
 
 // AsProto converts ArtifactSourceConfigImpl to its Protobuf representation.
 // used to pass default config to an external-plugin source
 func (b *ArtifactSourceConfigImpl) AsProto() *proto.ArtifactSourceConfig {
-	return &proto.ArtifactSourceConfig{
-		FileLayout: typehelpers.SafeString(b.FileLayout),
-		Patterns:   b.Patterns,
+	res := &proto.ArtifactSourceConfig{
+		FileLayouts: b.GetFileLayouts(),
+		Patterns:    b.Patterns,
 	}
+	// populate the single file layout for plugins which do not support multiple layouts
+	if len(res.FileLayouts) == 1 {
+		res.FileLayout = res.FileLayouts[0]
+	}
+	return res
 }
 
 func ArtifactSourceConfigBaseFromProto(pb *proto.ArtifactSourceConfig) *ArtifactSourceConfigImpl {
-	return &ArtifactSourceConfigImpl{
-		FileLayout: utils.ToPointer(pb.FileLayout),
-		Patterns:   pb.Patterns,
+	res := &ArtifactSourceConfigImpl{
+		Patterns: pb.Patterns,
+	}
+	res.setFileLayouts(pb.FileLayouts)
+	// handle requests from plugins which only set the single file layout
+	if len(res.FileLayouts) == 0 && pb.FileLayout != "" {
+		res.FileLayout = utils.ToPointer(pb.FileLayout)
 	}
+	return res
 }
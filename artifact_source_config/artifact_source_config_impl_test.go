@@ -1,116 +1,254 @@
 package artifact_source_config
 
-// #TODO reenable once we support filter pushdown https://github.com/turbot/tailpipe/issues/97
-//func TestArtifactSourceConfigBase_Validate(t *testing.T) {
-//	type fields struct {
-//		Remain     hcl.Body
-//		FileLayout *string
-//		Filters    []string
-//		FilterMap  map[string]*filter.SqlFilter
-//	}
-//	tests := []struct {
-//		name    string
-//		fields  fields
-//		wantErr bool
-//	}{
-//		{
-//			name: "Valid filters - single filter",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail"),
-//				Filters:    []string{"org = 'org1'"},
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Valid filters - multiple filters",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail/%{WORD:region}/%{NOTSPACE:file_name}.%{WORD:ext}"),
-//				Filters:    []string{"org = 'org1'", "region = 'us-east-1'"},
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Filter refer to field not in FileLayout",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail/%{NOTSPACE:file_name}.%{WORD:ext}"),
-//				Filters:    []string{"org = 'org1'", "region = 'us-east-1'"},
-//			},
-//			wantErr: true,
-//		},
-//		{
-//			name: "Invalid filter - no LHS property",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail"),
-//				Filters:    []string{"= 'org1'"},
-//			},
-//			wantErr: true,
-//		},
-//		{
-//			name: "Invalid filter - multiple LHS properties",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail"),
-//				Filters:    []string{"org = 'org1' AND account = '123'"},
-//			},
-//			wantErr: true,
-//		},
-//		{
-//			name: "Empty filters",
-//			fields: fields{
-//				Filters: []string{},
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Nil filters",
-//			fields: fields{
-//				Filters: nil,
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Invalid filter syntax",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail"),
-//				Filters:    []string{"org =="},
-//			},
-//			wantErr: true,
-//		},
-//		{
-//			name: "Duplicate filters for the same field",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/CloudTrail"),
-//				Filters:    []string{"org = 'org1'", "org != 'org2'"},
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Valid filters with FileLayout",
-//			fields: fields{
-//				FileLayout: utils.ToPointer("AWSLogs/%{WORD:org}/%{NUMBER:account_id}/CloudTrail"),
-//				Filters:    []string{"org = 'org1'", "account_id = '123'"},
-//			},
-//			wantErr: false,
-//		},
-//		{
-//			name: "Empty FileLayout and Filters",
-//			fields: fields{
-//				FileLayout: nil,
-//				Filters:    []string{},
-//			},
-//			wantErr: false,
-//		},
-//	}
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			b := &ArtifactSourceConfigImpl{
-//				Remain:     tt.fields.Remain,
-//				FileLayout: tt.fields.FileLayout,
-//				Filters:    tt.fields.Filters,
-//				FilterMap:  tt.fields.FilterMap,
-//			}
-//			if err := b.Validate(); (err != nil) != tt.wantErr {
-//				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
-//			}
-//		})
-//	}
-//}
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func TestArtifactSourceConfigImpl_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileLayouts []string
+		filters     []string
+		exclude     []string
+		onChange    *string
+		wantErr     bool
+	}{
+		{
+			name:        "Valid filters - single filter",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail"},
+			filters:     []string{"org = 'org1'"},
+		},
+		{
+			name:        "Valid filters - multiple filters",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail/%{WORD:region}/%{NOTSPACE:file_name}.%{WORD:ext}"},
+			filters:     []string{"org = 'org1'", "region = 'us-east-1'"},
+		},
+		{
+			name:        "Filter refer to field not in FileLayout",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail/%{NOTSPACE:file_name}.%{WORD:ext}"},
+			filters:     []string{"org = 'org1'", "region = 'us-east-1'"},
+			wantErr:     true,
+		},
+		{
+			name:        "Filter refers to field in every FileLayout",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail", "AWSLogs/%{WORD:org}/%{WORD:region}/CloudTrail"},
+			filters:     []string{"org = 'org1'"},
+		},
+		{
+			name:        "Filter refers to field only in second FileLayout",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail", "AWSLogs/%{WORD:org}/%{WORD:region}/CloudTrail"},
+			filters:     []string{"region = 'us-east-1'"},
+			wantErr:     true,
+		},
+		{
+			name:        "Invalid filter - no LHS property",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail"},
+			filters:     []string{"= 'org1'"},
+			wantErr:     true,
+		},
+		{
+			name:        "Invalid filter - multiple LHS properties",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail"},
+			filters:     []string{"org = 'org1' AND account = '123'"},
+			wantErr:     true,
+		},
+		{
+			name:    "Empty filters",
+			filters: []string{},
+		},
+		{
+			name: "Nil filters",
+		},
+		{
+			name:        "Invalid filter syntax",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail"},
+			filters:     []string{"org =="},
+			wantErr:     true,
+		},
+		{
+			name:        "Duplicate filters for the same field",
+			fileLayouts: []string{"AWSLogs/%{WORD:org}/CloudTrail"},
+			filters:     []string{"org = 'org1'", "org != 'org2'"},
+		},
+		{
+			name:    "Filters without FileLayout are validated when the default layout is applied",
+			filters: []string{"org = 'org1'"},
+		},
+		{
+			name:    "Valid exclude patterns",
+			exclude: []string{"**/*.tmp", "archive/*"},
+		},
+		{
+			name:    "Invalid exclude pattern",
+			exclude: []string{"archive/[a"},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid exclude pattern - unterminated alternatives",
+			exclude: []string{"archive/{a,b"},
+			wantErr: true,
+		},
+		{
+			name:        "Append uncompressed artifacts",
+			fileLayouts: []string{"logs/%{DATA}.log"},
+			onChange:    utils.ToPointer("append"),
+		},
+		{
+			name:        "Append compressed artifacts",
+			fileLayouts: []string{"logs/%{DATA}.log", "logs/%{DATA}.log.gz"},
+			onChange:    utils.ToPointer("append"),
+			wantErr:     true,
+		},
+		{
+			name:        "Recollect compressed artifacts",
+			fileLayouts: []string{"logs/%{DATA}.log.gz"},
+			onChange:    utils.ToPointer("recollect"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &ArtifactSourceConfigImpl{
+				FileLayouts: tt.fileLayouts,
+				Filters:     tt.filters,
+				Exclude:     tt.exclude,
+				OnChange:    tt.onChange,
+			}
+			if err := b.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArtifactSourceConfigImpl_FilterMap(t *testing.T) {
+	b := &ArtifactSourceConfigImpl{
+		FileLayouts: []string{"AWSLogs/%{WORD:org}/%{WORD:region}/CloudTrail"},
+		Filters:     []string{"org = 'org1'", "region in ('us-east-1', 'us-west-2')", "region != 'us-west-2'"},
+	}
+	assert.NoError(t, b.Validate())
+
+	filterMap := b.GetFilterMap()
+	assert.Len(t, filterMap, 2)
+	assert.True(t, filterMap["org"].Satisfied(map[string]string{"org": "org1"}))
+	assert.False(t, filterMap["org"].Satisfied(map[string]string{"org": "org2"}))
+	// both filters on region must be satisfied
+	assert.True(t, filterMap["region"].Satisfied(map[string]string{"region": "us-east-1"}))
+	assert.False(t, filterMap["region"].Satisfied(map[string]string{"region": "us-west-2"}))
+	assert.False(t, filterMap["region"].Satisfied(map[string]string{"region": "eu-west-1"}))
+}
+
+func TestArtifactSourceConfigImpl_resolveFileLayouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		hcl     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "single layout",
+			hcl:  `"logs/%%{YEAR:year}/%%{DATA}.log"`,
+			want: []string{"logs/%{YEAR:year}/%{DATA}.log"},
+		},
+		{
+			name: "list of layouts",
+			hcl:  `["logs/%%{YEAR:year}/%%{MONTHNUM:month}/%%{DATA}.log", "logs/%%{DATA}.log"]`,
+			want: []string{"logs/%{YEAR:year}/%{MONTHNUM:month}/%{DATA}.log", "logs/%{DATA}.log"},
+		},
+		{
+			name: "null",
+			hcl:  `null`,
+		},
+		{
+			name:    "empty list",
+			hcl:     `[]`,
+			wantErr: true,
+		},
+		{
+			name:    "number",
+			hcl:     `1`,
+			wantErr: true,
+		},
+		{
+			name:    "list containing an object",
+			hcl:     `["logs/%%{DATA}.log", {a = "b"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(tt.hcl), "test.hcl", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("failed to parse expression: %s", diags.Error())
+			}
+			b := &ArtifactSourceConfigImpl{FileLayoutExpr: expr}
+			err := b.resolveFileLayouts()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, b.GetFileLayouts())
+		})
+	}
+}
+
+// testSourceConfig overrides Validate without calling the base implementation
+type testSourceConfig struct {
+	ArtifactSourceConfigImpl
+
+	// required to allow partial decoding
+	Remain hcl.Body `hcl:",remain" json:"-"`
+}
+
+func (c *testSourceConfig) Validate() error {
+	return nil
+}
+
+func (c *testSourceConfig) Identifier() string {
+	return "test"
+}
+
+func TestArtifactSourceConfigImpl_fileLayoutResolvedOnParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		hcl       string
+		want      []string
+		wantFirst *string
+	}{
+		{
+			name:      "single layout",
+			hcl:       `file_layout = "logs/%%{DATA}.log"`,
+			want:      []string{"logs/%{DATA}.log"},
+			wantFirst: utils.ToPointer("logs/%{DATA}.log"),
+		},
+		{
+			name:      "list of layouts",
+			hcl:       `file_layout = ["logs/%%{YEAR:year}/%%{DATA}.log", "logs/%%{DATA}.log"]`,
+			want:      []string{"logs/%{YEAR:year}/%{DATA}.log", "logs/%{DATA}.log"},
+			wantFirst: utils.ToPointer("logs/%{YEAR:year}/%{DATA}.log"),
+		},
+		{
+			name: "no layout",
+			hcl:  `patterns = {}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configData := types.NewSourceConfigData([]byte(tt.hcl), hcl.Range{Filename: "test.hcl"}, "test")
+			c, err := parse.ParseConfig[*testSourceConfig](configData)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, c.GetFileLayouts())
+			assert.Equal(t, tt.wantFirst, c.GetFileLayout())
+			assert.Equal(t, tt.wantFirst, c.FileLayout)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package artifact_source_config
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ChangePolicy determines how artifacts which have changed since they were collected are handled
+type ChangePolicy string
+
+const (
+	// ChangePolicyIgnore - artifacts are never collected again once collected (the default)
+	ChangePolicyIgnore ChangePolicy = "ignore"
+	// ChangePolicyRecollect - changed artifacts are collected again in full
+	ChangePolicyRecollect ChangePolicy = "recollect"
+	// ChangePolicyAppend - only the lines appended to changed artifacts are collected
+	// (if an artifact has shrunk, it has been rewritten and is collected again in full)
+	// this is only valid for uncompressed, line based artifacts
+	ChangePolicyAppend ChangePolicy = "append"
+)
+
+func (p ChangePolicy) Validate() error {
+	switch p {
+	case ChangePolicyIgnore, ChangePolicyRecollect, ChangePolicyAppend:
+		return nil
+	default:
+		return fmt.Errorf("invalid on_change value '%s' - must be one of %s, %s, %s", string(p), ChangePolicyIgnore, ChangePolicyRecollect, ChangePolicyAppend)
+	}
+}
+
+// compressedExtensions are the extensions of compressed artifacts - data appended to these cannot be split
+// from the data already collected
+var compressedExtensions = []string{".gz", ".tgz", ".zip", ".bz2", ".xz", ".zst"}
+
+// IsCompressedPath returns whether the path has the extension of a compressed artifact
+func IsCompressedPath(p string) bool {
+	return slices.Contains(compressedExtensions, strings.ToLower(path.Ext(p)))
+}
+
+// ValidateChangePolicyFileLayouts returns an error if the change policy is append and any of the file layouts
+// matches compressed artifacts
+func ValidateChangePolicyFileLayouts(policy ChangePolicy, fileLayouts []string) error {
+	if policy != ChangePolicyAppend {
+		return nil
+	}
+	for _, fileLayout := range fileLayouts {
+		if IsCompressedPath(fileLayout) {
+			return fmt.Errorf("on_change = %s cannot be used with compressed artifacts (file_layout '%s')", ChangePolicyAppend, fileLayout)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package artifact_source_config
+
+import (
+	"fmt"
+
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// ChecksumConfig configures verification of downloaded artifacts against digest files stored alongside them
+// (for example 'access.log.gz.sha256', as written by sha256sum)
+type ChecksumConfig struct {
+	// the checksum algorithm - one of md5, sha256 or crc32c
+	Algorithm string `hcl:"algorithm"`
+	// the suffix appended to the artifact name to give the digest file name (defaults to '.<algorithm>')
+	FileSuffix *string `hcl:"file_suffix,optional"`
+}
+
+func (c *ChecksumConfig) Validate() error {
+	if err := types.ChecksumAlgorithm(c.Algorithm).Validate(); err != nil {
+		return err
+	}
+	if c.FileSuffix != nil && *c.FileSuffix == "" {
+		return fmt.Errorf("file_suffix cannot be empty")
+	}
+	return nil
+}
+
+// GetFileSuffix returns the digest file suffix, or '.<algorithm>' if not set
+func (c *ChecksumConfig) GetFileSuffix() string {
+	if c.FileSuffix != nil {
+		return *c.FileSuffix
+	}
+	return "." + c.Algorithm
+}
+
+// GetDigestFile returns the digest file for the given artifact
+func (c *ChecksumConfig) GetDigestFile(artifactName string) *types.DigestFile {
+	return &types.DigestFile{
+		Algorithm: types.ChecksumAlgorithm(c.Algorithm),
+		Name:      artifactName + c.GetFileSuffix(),
+	}
+}
@@ -0,0 +1,97 @@
+package artifact_source_config
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/turbot/tailpipe-plugin-sdk/rate_limiter"
+	"golang.org/x/time/rate"
+)
+
+// LimiterConfig configures the concurrency and rate at which artifacts are downloaded
+type LimiterConfig struct {
+	// the maximum number of concurrent downloads
+	MaxConcurrency *int64 `hcl:"max_concurrency,optional"`
+	// the maximum number of downloads started per second
+	RequestsPerSecond *float64 `hcl:"requests_per_second,optional"`
+	// the number of downloads which may be started at once before the rate limit applies
+	// (defaults to the requests per second, rounded up)
+	Burst *int64 `hcl:"burst,optional"`
+	// if set, the concurrency and rate are reduced when downloads are throttled by the server,
+	// then increased again (up to the configured limits) as downloads succeed
+	Adaptive *bool `hcl:"adaptive,optional"`
+	// the maximum aggregate download throughput per second (e.g. "10MB")
+	Bandwidth *string `hcl:"bandwidth,optional"`
+}
+
+func (c *LimiterConfig) Validate() error {
+	if c.MaxConcurrency != nil && *c.MaxConcurrency <= 0 {
+		return fmt.Errorf("max_concurrency must be greater than zero")
+	}
+	if c.RequestsPerSecond != nil && *c.RequestsPerSecond <= 0 {
+		return fmt.Errorf("requests_per_second must be greater than zero")
+	}
+	if c.Burst != nil {
+		if *c.Burst <= 0 {
+			return fmt.Errorf("burst must be greater than zero")
+		}
+		if c.RequestsPerSecond == nil {
+			return fmt.Errorf("burst cannot be set without requests_per_second")
+		}
+	}
+
+	// if only the bandwidth is set, the default download limits apply
+	if c.Bandwidth == nil || c.MaxConcurrency != nil || c.RequestsPerSecond != nil || c.Adaptive != nil {
+		if validationErrors := c.GetDefinition("artifact_source_limiter", 0).Validate(); len(validationErrors) > 0 {
+			return fmt.Errorf("%s", strings.Join(validationErrors, ", "))
+		}
+	}
+	if c.Bandwidth != nil {
+		bandwidth, err := humanize.ParseBytes(*c.Bandwidth)
+		if err != nil {
+			return fmt.Errorf("invalid bandwidth '%s': %w", *c.Bandwidth, err)
+		}
+		if bandwidth == 0 {
+			return fmt.Errorf("bandwidth must be greater than zero")
+		}
+		if validationErrors := c.GetBandwidthDefinition("artifact_source_bandwidth_limiter").Validate(); len(validationErrors) > 0 {
+			return fmt.Errorf("%s", strings.Join(validationErrors, ", "))
+		}
+	}
+	return nil
+}
+
+// GetDefinition returns the rate limiter definition for the config,
+// using the given max concurrency if none is set
+func (c *LimiterConfig) GetDefinition(name string, defaultMaxConcurrency int64) *rate_limiter.Definition {
+	d := &rate_limiter.Definition{
+		Name:           name,
+		MaxConcurrency: defaultMaxConcurrency,
+	}
+	if c.MaxConcurrency != nil {
+		d.MaxConcurrency = *c.MaxConcurrency
+	}
+	if c.Adaptive != nil {
+		d.Adaptive = *c.Adaptive
+	}
+	if c.RequestsPerSecond != nil {
+		d.FillRate = rate.Limit(*c.RequestsPerSecond)
+		d.BucketSize = int64(math.Ceil(*c.RequestsPerSecond))
+		if c.Burst != nil {
+			d.BucketSize = *c.Burst
+		}
+	}
+	return d
+}
+
+// GetBandwidthDefinition returns the bandwidth limiter definition for the config, or nil if the bandwidth is not set
+func (c *LimiterConfig) GetBandwidthDefinition(name string) *rate_limiter.Definition {
+	if c.Bandwidth == nil {
+		return nil
+	}
+	// the value has already been validated
+	bandwidth, _ := humanize.ParseBytes(*c.Bandwidth)
+	return rate_limiter.NewBandwidthDefinition(name, int64(bandwidth))
+}
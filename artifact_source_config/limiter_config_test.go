@@ -0,0 +1,70 @@
+package artifact_source_config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/rate_limiter"
+	"golang.org/x/time/rate"
+)
+
+func TestLimiterConfig_GetDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  LimiterConfig
+		want    *rate_limiter.Definition
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name:   "max concurrency",
+			config: LimiterConfig{MaxConcurrency: utils.ToPointer(int64(4))},
+			want:   &rate_limiter.Definition{Name: "test", MaxConcurrency: 4},
+		},
+		{
+			name:   "rate with default burst",
+			config: LimiterConfig{RequestsPerSecond: utils.ToPointer(2.5)},
+			want:   &rate_limiter.Definition{Name: "test", FillRate: rate.Limit(2.5), BucketSize: 3, MaxConcurrency: 16},
+		},
+		{
+			name:   "rate with burst",
+			config: LimiterConfig{RequestsPerSecond: utils.ToPointer(10.0), Burst: utils.ToPointer(int64(20)), MaxConcurrency: utils.ToPointer(int64(32))},
+			want:   &rate_limiter.Definition{Name: "test", FillRate: rate.Limit(10), BucketSize: 20, MaxConcurrency: 32},
+		},
+		{
+			name:   "bandwidth only",
+			config: LimiterConfig{Bandwidth: utils.ToPointer("10MB")},
+			want:   &rate_limiter.Definition{Name: "test", MaxConcurrency: 16},
+		},
+		{
+			name:    "invalid bandwidth",
+			config:  LimiterConfig{Bandwidth: utils.ToPointer("fast")},
+			wantErr: true,
+		},
+		{
+			name:    "burst without rate",
+			config:  LimiterConfig{Burst: utils.ToPointer(int64(20))},
+			wantErr: true,
+		},
+		{
+			name:    "negative concurrency",
+			config:  LimiterConfig{MaxConcurrency: utils.ToPointer(int64(-1))},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, tt.config.GetDefinition("test", 16))
+		})
+	}
+}
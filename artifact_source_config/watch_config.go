@@ -0,0 +1,65 @@
+package artifact_source_config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultWatchSettleDelay is the default time a file must be unchanged before it is collected in watch mode
+	DefaultWatchSettleDelay = 2 * time.Second
+	// DefaultWatchFlushInterval is the default interval at which rows are flushed and collection state saved in watch mode
+	DefaultWatchFlushInterval = 30 * time.Second
+)
+
+// WatchConfig is the config for watch mode - in watch mode, after the initial discovery the source
+// continues to collect new artifacts as they appear, until the collection is cancelled
+type WatchConfig struct {
+	// how long a file must be unchanged before it is collected (so that files being written are not collected early)
+	SettleDelay *string `hcl:"settle_delay,optional"`
+	// how often buffered rows are written and the collection state saved
+	FlushInterval *string `hcl:"flush_interval,optional"`
+}
+
+func (c *WatchConfig) Validate() error {
+	if err := validatePositiveDuration("settle_delay", c.SettleDelay); err != nil {
+		return err
+	}
+	return validatePositiveDuration("flush_interval", c.FlushInterval)
+}
+
+// GetSettleDelay returns the settle delay, or DefaultWatchSettleDelay if not set
+func (c *WatchConfig) GetSettleDelay() time.Duration {
+	return durationOrDefault(c.SettleDelay, DefaultWatchSettleDelay)
+}
+
+// GetFlushInterval returns the flush interval, or DefaultWatchFlushInterval if not set
+func (c *WatchConfig) GetFlushInterval() time.Duration {
+	return durationOrDefault(c.FlushInterval, DefaultWatchFlushInterval)
+}
+
+func validatePositiveDuration(name string, value *string) error {
+	if value == nil {
+		return nil
+	}
+	d, err := time.ParseDuration(*value)
+	if err != nil {
+		return fmt.Errorf("invalid %s '%s': %w", name, *value, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%s must be greater than zero", name)
+	}
+	return nil
+}
+
+// durationOrDefault parses the duration (which has already been validated), returning the default if not set
+func durationOrDefault(value *string, defaultValue time.Duration) time.Duration {
+	if value == nil {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(*value)
+	if err != nil {
+		return defaultValue
+	}
+	return d
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
 	"github.com/turbot/tailpipe-plugin-sdk/constants"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
 )
 
 const MinArtifactGranularity = time.Hour * 24
@@ -30,6 +31,15 @@ type ArtifactCollectionStateImpl[T artifact_source_config.ArtifactSourceConfig]
 	// NOTE: this assumes forward collection
 	LastModifiedTime time.Time `json:"last_modified_time,omitempty"`
 
+	// map of object identifier to the version collected, for recently collected objects
+	// this is only populated if the source provides artifact versions (see OnArtifactCollected)
+	// and is used to detect objects which have changed since they were collected
+	ArtifactVersions map[string]*types.ArtifactVersion `json:"artifact_versions,omitempty"`
+
+	// set of identifiers of objects which have changed since they were collected, and are being collected again
+	// (these have already been recorded in their trunk state)
+	pendingChanged map[string]struct{}
+
 	// map of object identifier to collection state which contains the object
 	// used to store the collection state for each object between the ShouldCollect call and the OnCollected call
 	// NOTE: the map entry is cleared after OnCollected is called to minimise memory usage
@@ -47,9 +57,11 @@ type ArtifactCollectionStateImpl[T artifact_source_config.ArtifactSourceConfig]
 
 func NewArtifactCollectionStateImpl[T artifact_source_config.ArtifactSourceConfig]() CollectionState[T] {
 	return &ArtifactCollectionStateImpl[T]{
-		TrunkStates:    make(map[string]*TimeRangeCollectionStateImpl),
-		objectStateMap: make(map[string]*TimeRangeCollectionStateImpl),
-		mut:            &sync.RWMutex{},
+		TrunkStates:      make(map[string]*TimeRangeCollectionStateImpl),
+		ArtifactVersions: make(map[string]*types.ArtifactVersion),
+		pendingChanged:   make(map[string]struct{}),
+		objectStateMap:   make(map[string]*TimeRangeCollectionStateImpl),
+		mut:              &sync.RWMutex{},
 	}
 }
 
@@ -70,6 +82,9 @@ func (s *ArtifactCollectionStateImpl[T]) Init(_ T, path string) error {
 			return fmt.Errorf("failed to unmarshal collection state file '%s': %w", path, err)
 		}
 	}
+	if s.ArtifactVersions == nil {
+		s.ArtifactVersions = make(map[string]*types.ArtifactVersion)
+	}
 	return nil
 }
 
@@ -150,13 +165,15 @@ func (s *ArtifactCollectionStateImpl[T]) SetEndTime(newEndTime time.Time) {
 func (s *ArtifactCollectionStateImpl[T]) Clear() {
 	s.mut.Lock()
 	defer s.mut.Unlock()
-	// cleat the map
+	// cleat the maps
 	s.TrunkStates = make(map[string]*TimeRangeCollectionStateImpl)
+	s.ArtifactVersions = make(map[string]*types.ArtifactVersion)
 }
 
 // RegisterPath registers a path with the collection state - we determine whether this is a potential trunk
 // (i.e. a path segment with no time metadata for which we need to track collection state separately)
 // and if so, add it to the map of trunk states
+// this may be called concurrently (see artifact_source.WalkParallel)
 func (s *ArtifactCollectionStateImpl[T]) RegisterPath(path string, metadata map[string]string) {
 	// if this a trunk (i.e. there is no time component)
 	// if so, add an entry in the trunk states map
@@ -164,6 +181,9 @@ func (s *ArtifactCollectionStateImpl[T]) RegisterPath(path string, metadata map[
 		return
 	}
 
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
 	// do we already have a trunk that covers this path?
 	var trunksToDelete []string
 	for t := range s.TrunkStates {
@@ -193,6 +213,35 @@ func (s *ArtifactCollectionStateImpl[T]) RegisterPath(path string, metadata map[
 func (s *ArtifactCollectionStateImpl[T]) ShouldCollect(id string, timestamp time.Time) bool {
 	s.mut.Lock()
 	defer s.mut.Unlock()
+
+	return s.shouldCollect(id, timestamp)
+}
+
+// ShouldCollectArtifact returns whether the object should be collected, based on the time metadata in the object
+// and, if the object has been collected recently, whether its version has changed since it was collected
+// if the version is nil, this is equivalent to ShouldCollect
+func (s *ArtifactCollectionStateImpl[T]) ShouldCollectArtifact(id string, timestamp time.Time, version *types.ArtifactVersion) (ShouldCollectResult, *types.ArtifactVersion) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.shouldCollect(id, timestamp) {
+		return ShouldCollectNew, nil
+	}
+	if version == nil {
+		return ShouldCollectSkip, nil
+	}
+	// if we have the version we collected, check whether the object has changed
+	// (if we do not, the object was not collected recently - assume it has not changed)
+	prev, ok := s.ArtifactVersions[id]
+	if !ok || prev.Equals(version) {
+		return ShouldCollectSkip, nil
+	}
+	s.pendingChanged[id] = struct{}{}
+	res := *prev
+	return ShouldCollectChanged, &res
+}
+
+func (s *ArtifactCollectionStateImpl[T]) shouldCollect(id string, timestamp time.Time) bool {
 	rootChar := "/"
 
 	// find the trunk state for this object
@@ -239,12 +288,30 @@ func (s *ArtifactCollectionStateImpl[T]) ShouldCollect(id string, timestamp time
 
 // OnCollected is called when an object has been collected - update our end time and end objects if needed
 func (s *ArtifactCollectionStateImpl[T]) OnCollected(id string, timestamp time.Time) error {
+	return s.OnArtifactCollected(id, timestamp, nil)
+}
+
+// OnArtifactCollected is called when an object has been collected - store the version which was collected (if any)
+// and update our end time and end objects if needed
+func (s *ArtifactCollectionStateImpl[T]) OnArtifactCollected(id string, timestamp time.Time, version *types.ArtifactVersion) error {
 	s.mut.Lock()
 	defer s.mut.Unlock()
 
 	// store modified time to ensure we save the state
 	s.LastModifiedTime = time.Now()
 
+	if version != nil {
+		v := *version
+		v.CollectedAt = s.LastModifiedTime
+		s.ArtifactVersions[id] = &v
+	}
+
+	// if this is a changed object, it has already been recorded in its trunk state
+	if _, changed := s.pendingChanged[id]; changed {
+		delete(s.pendingChanged, id)
+		return nil
+	}
+
 	// we should have stored a collection state mapping for this object
 	collectionState, ok := s.objectStateMap[id]
 	if !ok {
@@ -267,6 +334,8 @@ func (s *ArtifactCollectionStateImpl[T]) Save() error {
 		return nil
 	}
 
+	s.pruneArtifactVersions()
+
 	jsonBytes, err := json.Marshal(s)
 	if err != nil {
 		return err
@@ -307,6 +376,19 @@ func (s *ArtifactCollectionStateImpl[T]) IsEmpty() bool {
 	return true
 }
 
+// pruneArtifactVersions removes the versions of objects which were not collected recently
+// - objects which were collected more than two granularity periods before the last collection
+// are assumed to be complete
+func (s *ArtifactCollectionStateImpl[T]) pruneArtifactVersions() {
+	retention := 2 * max(s.granularity, MinArtifactGranularity)
+	cutoff := s.LastModifiedTime.Add(-retention)
+	for id, version := range s.ArtifactVersions {
+		if version.CollectedAt.Before(cutoff) {
+			delete(s.ArtifactVersions, id)
+		}
+	}
+}
+
 // helper to determine if the metadata contains any time metadata
 func (s *ArtifactCollectionStateImpl[T]) containsTimeMetadata(metadata map[string]string) bool {
 	// check for any time metadata
@@ -1 +1,114 @@
 package collection_state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func TestArtifactCollectionStateImpl_ShouldCollectArtifact(t *testing.T) {
+	modTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	collected := &types.ArtifactVersion{Size: 100, ModTime: modTime}
+
+	tests := []struct {
+		name string
+		// the version which was collected
+		collected *types.ArtifactVersion
+		version   *types.ArtifactVersion
+		want      ShouldCollectResult
+	}{
+		{
+			name:      "no version",
+			collected: collected,
+			want:      ShouldCollectSkip,
+		},
+		{
+			name:      "unchanged",
+			collected: collected,
+			version:   &types.ArtifactVersion{Size: 100, ModTime: modTime},
+			want:      ShouldCollectSkip,
+		},
+		{
+			name:      "appended",
+			collected: collected,
+			version:   &types.ArtifactVersion{Size: 150, ModTime: modTime.Add(time.Minute)},
+			want:      ShouldCollectChanged,
+		},
+		{
+			name:      "etag unchanged",
+			collected: &types.ArtifactVersion{Size: 100, ModTime: modTime, ETag: `"abc"`},
+			version:   &types.ArtifactVersion{Size: 100, ModTime: modTime.Add(time.Minute), ETag: `"abc"`},
+			want:      ShouldCollectSkip,
+		},
+		{
+			name:    "collected without a version",
+			version: &types.ArtifactVersion{Size: 150, ModTime: modTime},
+			want:    ShouldCollectSkip,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl]().(*ArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl])
+
+			// collect the artifact
+			res, _ := s.ShouldCollectArtifact("/logs/app.log", time.Time{}, tt.collected)
+			assert.Equal(t, ShouldCollectNew, res)
+			assert.NoError(t, s.OnArtifactCollected("/logs/app.log", time.Time{}, tt.collected))
+
+			got, prev := s.ShouldCollectArtifact("/logs/app.log", time.Time{}, tt.version)
+			assert.Equal(t, tt.want, got)
+			if got != ShouldCollectChanged {
+				return
+			}
+			if assert.NotNil(t, prev) {
+				assert.Equal(t, tt.collected.Size, prev.Size)
+			}
+			// the changed artifact must be collectable, and the new version stored
+			assert.NoError(t, s.OnArtifactCollected("/logs/app.log", time.Time{}, tt.version))
+			got, _ = s.ShouldCollectArtifact("/logs/app.log", time.Time{}, tt.version)
+			assert.Equal(t, ShouldCollectSkip, got)
+		})
+	}
+}
+
+// the version stored is the version which was collected, not the version when the artifact was discovered
+func TestArtifactCollectionStateImpl_OnArtifactCollected(t *testing.T) {
+	s := NewArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl]().(*ArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl])
+	modTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	discovered := &types.ArtifactVersion{Size: 100, ModTime: modTime}
+	// the artifact was appended to between discovery and download
+	downloaded := &types.ArtifactVersion{Size: 150, ModTime: modTime.Add(time.Minute)}
+
+	res, _ := s.ShouldCollectArtifact("/logs/app.log", time.Time{}, discovered)
+	assert.Equal(t, ShouldCollectNew, res)
+	assert.NoError(t, s.OnArtifactCollected("/logs/app.log", time.Time{}, downloaded))
+
+	// the data up to the downloaded size has been collected
+	res, _ = s.ShouldCollectArtifact("/logs/app.log", time.Time{}, downloaded)
+	assert.Equal(t, ShouldCollectSkip, res)
+
+	appended := &types.ArtifactVersion{Size: 200, ModTime: modTime.Add(2 * time.Minute)}
+	res, prev := s.ShouldCollectArtifact("/logs/app.log", time.Time{}, appended)
+	assert.Equal(t, ShouldCollectChanged, res)
+	if assert.NotNil(t, prev) {
+		assert.Equal(t, downloaded.Size, prev.Size)
+	}
+}
+
+func TestArtifactCollectionStateImpl_pruneArtifactVersions(t *testing.T) {
+	s := NewArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl]().(*ArtifactCollectionStateImpl[*artifact_source_config.ArtifactSourceConfigImpl])
+	s.SetGranularity(time.Hour)
+	now := time.Now()
+	s.LastModifiedTime = now
+	s.ArtifactVersions = map[string]*types.ArtifactVersion{
+		"recent": {Size: 1, CollectedAt: now.Add(-time.Hour)},
+		// the granularity is raised to the minimum of a day, so versions are kept for two days
+		"old": {Size: 1, CollectedAt: now.Add(-72 * time.Hour)},
+	}
+	s.pruneArtifactVersions()
+	assert.Contains(t, s.ArtifactVersions, "recent")
+	assert.NotContains(t, s.ArtifactVersions, "old")
+}
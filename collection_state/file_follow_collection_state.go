@@ -0,0 +1,218 @@
+package collection_state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// FollowedFileState is the collection state for a single followed file
+type FollowedFileState struct {
+	// the inode of the file when it was last read (zero if not supported by the platform)
+	Inode uint64 `json:"inode,omitempty"`
+	// the byte offset we have read the file up to
+	Offset int64 `json:"offset"`
+	// hash of the start of the file - used to detect a file which has been truncated and has then grown
+	// past the previous offset
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// FileFollowCollectionState is the collection state used when following continuously growing files
+// rather than tracking collected artifacts by time, it tracks the inode and the byte offset read for each file
+type FileFollowCollectionState[T parse.Config] struct {
+	// map of file path to the state of that file
+	Files map[string]*FollowedFileState `json:"files,omitempty"`
+
+	// the time the last file was collected
+	LastModifiedTime time.Time `json:"last_modified_time,omitempty"`
+
+	// map of file path to the state the file will have once it has been collected
+	// this is set by the source before the file is discovered and is committed to Files by OnCollected
+	pendingFiles map[string]*FollowedFileState
+
+	granularity time.Duration
+
+	// path to the serialised collection state JSON
+	jsonPath     string
+	lastSaveTime time.Time
+
+	mut *sync.RWMutex
+}
+
+func NewFileFollowCollectionState[T parse.Config]() CollectionState[T] {
+	return &FileFollowCollectionState[T]{
+		Files:        make(map[string]*FollowedFileState),
+		pendingFiles: make(map[string]*FollowedFileState),
+		mut:          &sync.RWMutex{},
+	}
+}
+
+// Init sets the filepath of the collection state and loads the state from the file if it exists
+func (s *FileFollowCollectionState[T]) Init(_ T, path string) error {
+	s.jsonPath = path
+
+	// if there is a file at the path, load it
+	if _, err := os.Stat(path); err == nil {
+		// read the file
+		jsonBytes, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read collection state file '%s': %w", path, err)
+		}
+		err = json.Unmarshal(jsonBytes, s)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal collection state file '%s': %w", path, err)
+		}
+	}
+	if s.Files == nil {
+		s.Files = make(map[string]*FollowedFileState)
+	}
+	return nil
+}
+
+func (s *FileFollowCollectionState[T]) SetGranularity(granularity time.Duration) {
+	s.granularity = granularity
+}
+
+func (s *FileFollowCollectionState[T]) GetGranularity() time.Duration {
+	return s.granularity
+}
+
+// GetStartTime returns a zero time - followed files are tracked by offset, not time
+func (s *FileFollowCollectionState[T]) GetStartTime() time.Time {
+	return time.Time{}
+}
+
+// GetEndTime returns the time the last file was collected
+func (s *FileFollowCollectionState[T]) GetEndTime() time.Time {
+	return s.LastModifiedTime
+}
+
+// SetEndTime is called when we are using the --from flag to force recollection
+// - if the new end time is before the last collection, clear the state so all files are read from the start
+func (s *FileFollowCollectionState[T]) SetEndTime(newEndTime time.Time) {
+	if newEndTime.Before(s.LastModifiedTime) {
+		s.Clear()
+	}
+}
+
+func (s *FileFollowCollectionState[T]) Clear() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.Files = make(map[string]*FollowedFileState)
+}
+
+// RegisterPath is a no-op - followed files do not use trunk states
+func (s *FileFollowCollectionState[T]) RegisterPath(string, map[string]string) {}
+
+// ShouldCollectArtifact returns ShouldCollectNew if ShouldCollect returns true
+// - this state already detects changed files, so the version is not used
+func (s *FileFollowCollectionState[T]) ShouldCollectArtifact(id string, timestamp time.Time, _ *types.ArtifactVersion) (ShouldCollectResult, *types.ArtifactVersion) {
+	if s.ShouldCollect(id, timestamp) {
+		return ShouldCollectNew, nil
+	}
+	return ShouldCollectSkip, nil
+}
+
+// OnArtifactCollected calls OnCollected - this state already detects changed files, so the version is not stored
+func (s *FileFollowCollectionState[T]) OnArtifactCollected(id string, timestamp time.Time, _ *types.ArtifactVersion) error {
+	return s.OnCollected(id, timestamp)
+}
+
+// GetFileState returns a copy of the state of the given file, or nil if the file has not been collected
+func (s *FileFollowCollectionState[T]) GetFileState(path string) *FollowedFileState {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	state, ok := s.Files[path]
+	if !ok {
+		return nil
+	}
+	res := *state
+	return &res
+}
+
+// SetPendingFileState sets the state the file will have once it has been collected
+// a file is only collected if it has a pending state
+func (s *FileFollowCollectionState[T]) SetPendingFileState(path string, state *FollowedFileState) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.pendingFiles[path] = state
+}
+
+// ShouldCollect returns whether the file has any data pending collection
+func (s *FileFollowCollectionState[T]) ShouldCollect(id string, _ time.Time) bool {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	_, ok := s.pendingFiles[id]
+	return ok
+}
+
+// OnCollected commits the pending state for the file
+func (s *FileFollowCollectionState[T]) OnCollected(id string, _ time.Time) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	state, ok := s.pendingFiles[id]
+	if !ok {
+		return fmt.Errorf("no pending state found for file '%s' - this should have been set before the file was discovered", id)
+	}
+	delete(s.pendingFiles, id)
+
+	// store modified time to ensure we save the state
+	s.LastModifiedTime = time.Now()
+	s.Files[id] = state
+	return nil
+}
+
+// Save serialises the collection state to a JSON file
+func (s *FileFollowCollectionState[T]) Save() error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	// if the last save time is after the last modified time, then we have nothing to do
+	if s.lastSaveTime.After(s.LastModifiedTime) {
+		// nothing to do
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	// ensure the target file path is valid
+	if s.jsonPath == "" {
+		return fmt.Errorf("collection state path is not set")
+	}
+
+	// if we are empty, delete the file
+	if s.IsEmpty() {
+		err := os.Remove(s.jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete collection state file: %w", err)
+		}
+		return nil
+	}
+
+	// write the JSON data to the file, overwriting any existing data
+	err = os.WriteFile(s.jsonPath, jsonBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write collection state to file: %w", err)
+	}
+
+	// update the last save time
+	s.lastSaveTime = time.Now()
+
+	return nil
+}
+
+// IsEmpty returns whether the collection state is empty
+func (s *FileFollowCollectionState[T]) IsEmpty() bool {
+	return len(s.Files) == 0
+}
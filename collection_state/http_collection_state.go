@@ -0,0 +1,269 @@
+package collection_state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// HttpObjectState is the collection state for a single object downloaded over HTTP
+// it stores the validators returned by the server, which are used to make conditional requests
+type HttpObjectState struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	// the timestamp of the object, parsed from the file layout (zero if the layout has no time fields)
+	Timestamp time.Time `json:"timestamp,omitempty"`
+}
+
+// HttpCollectionState is the collection state used by the HTTP artifact source
+// it tracks the validators of each object collected, so objects are only collected again if they have changed
+type HttpCollectionState[T parse.Config] struct {
+	// map of URL to the state of that object
+	Objects map[string]*HttpObjectState `json:"objects,omitempty"`
+
+	// the time the last object was collected
+	LastModifiedTime time.Time `json:"last_modified_time,omitempty"`
+
+	// map of URL to the state the object will have once it has been collected
+	// this is set by the source before the object is discovered and is committed to Objects by OnCollected
+	pendingObjects map[string]*HttpObjectState
+
+	granularity time.Duration
+
+	// path to the serialised collection state JSON
+	jsonPath     string
+	lastSaveTime time.Time
+
+	mut *sync.RWMutex
+}
+
+func NewHttpCollectionState[T parse.Config]() CollectionState[T] {
+	return &HttpCollectionState[T]{
+		Objects:        make(map[string]*HttpObjectState),
+		pendingObjects: make(map[string]*HttpObjectState),
+		mut:            &sync.RWMutex{},
+	}
+}
+
+// Init sets the filepath of the collection state and loads the state from the file if it exists
+func (s *HttpCollectionState[T]) Init(_ T, path string) error {
+	s.jsonPath = path
+
+	// if there is a file at the path, load it
+	if _, err := os.Stat(path); err == nil {
+		// read the file
+		jsonBytes, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read collection state file '%s': %w", path, err)
+		}
+		err = json.Unmarshal(jsonBytes, s)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal collection state file '%s': %w", path, err)
+		}
+	}
+	if s.Objects == nil {
+		s.Objects = make(map[string]*HttpObjectState)
+	}
+	return nil
+}
+
+func (s *HttpCollectionState[T]) SetGranularity(granularity time.Duration) {
+	s.granularity = granularity
+}
+
+func (s *HttpCollectionState[T]) GetGranularity() time.Duration {
+	return s.granularity
+}
+
+// GetStartTime returns a zero time - objects are tracked individually, not by time
+func (s *HttpCollectionState[T]) GetStartTime() time.Time {
+	return time.Time{}
+}
+
+// GetEndTime returns the start of the retention window, i.e. the timestamp of the latest object collected, less
+// twice the granularity - objects in this window are kept in the state and are discovered again by the next
+// collection, so objects which are still being written, or which are published late, are collected if they change
+// if no object has a timestamp (i.e. the file layout has no time fields), a zero time is returned
+func (s *HttpCollectionState[T]) GetEndTime() time.Time {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	return s.retentionStart()
+}
+
+// retentionStart returns the timestamp of the latest object less the retention period
+// (the caller must hold the lock)
+func (s *HttpCollectionState[T]) retentionStart() time.Time {
+	var latest time.Time
+	for _, state := range s.Objects {
+		if state.Timestamp.After(latest) {
+			latest = state.Timestamp
+		}
+	}
+	if latest.IsZero() {
+		return latest
+	}
+	retention := 2 * max(s.granularity, MinArtifactGranularity)
+	return latest.Add(-retention)
+}
+
+// SetEndTime is called when we are using the --from flag to force recollection
+// - if the new end time is before the last collection, clear the state so all objects are collected again
+func (s *HttpCollectionState[T]) SetEndTime(newEndTime time.Time) {
+	if newEndTime.Before(s.LastModifiedTime) {
+		s.Clear()
+	}
+}
+
+func (s *HttpCollectionState[T]) Clear() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.Objects = make(map[string]*HttpObjectState)
+}
+
+// RegisterPath is a no-op - objects are tracked individually
+func (s *HttpCollectionState[T]) RegisterPath(string, map[string]string) {}
+
+// ShouldCollectArtifact returns ShouldCollectNew if ShouldCollect returns true
+// - this state already detects changed objects, so the version is not used
+func (s *HttpCollectionState[T]) ShouldCollectArtifact(id string, timestamp time.Time, _ *types.ArtifactVersion) (ShouldCollectResult, *types.ArtifactVersion) {
+	if s.ShouldCollect(id, timestamp) {
+		return ShouldCollectNew, nil
+	}
+	return ShouldCollectSkip, nil
+}
+
+// OnArtifactCollected calls OnCollected - this state already detects changed objects, so the version is not stored
+func (s *HttpCollectionState[T]) OnArtifactCollected(id string, timestamp time.Time, _ *types.ArtifactVersion) error {
+	return s.OnCollected(id, timestamp)
+}
+
+// GetObjectState returns a copy of the state of the given object, or nil if it has not been collected
+func (s *HttpCollectionState[T]) GetObjectState(url string) *HttpObjectState {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	state, ok := s.Objects[url]
+	if !ok {
+		return nil
+	}
+	res := *state
+	return &res
+}
+
+// SetPendingObjectState sets the state the object will have once it has been collected
+// an object is only collected if it has a pending state
+func (s *HttpCollectionState[T]) SetPendingObjectState(url string, state *HttpObjectState) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.pendingObjects[url] = state
+}
+
+// ClearPendingObjectState removes the pending state of an object which is not going to be collected
+func (s *HttpCollectionState[T]) ClearPendingObjectState(url string) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	delete(s.pendingObjects, url)
+}
+
+// ShouldCollect returns whether the object is new or has changed (i.e. has a pending state)
+func (s *HttpCollectionState[T]) ShouldCollect(id string, _ time.Time) bool {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	_, ok := s.pendingObjects[id]
+	return ok
+}
+
+// OnCollected commits the pending state for the object, along with the object timestamp
+func (s *HttpCollectionState[T]) OnCollected(id string, timestamp time.Time) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	state, ok := s.pendingObjects[id]
+	if !ok {
+		return fmt.Errorf("no pending state found for object '%s' - this should have been set before the object was discovered", id)
+	}
+	delete(s.pendingObjects, id)
+
+	// store modified time to ensure we save the state
+	s.LastModifiedTime = time.Now()
+	if state == nil {
+		state = &HttpObjectState{}
+	}
+	state.Timestamp = timestamp
+	s.Objects[id] = state
+	return nil
+}
+
+// Save serialises the collection state to a JSON file
+func (s *HttpCollectionState[T]) Save() error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	// if the last save time is after the last modified time, then we have nothing to do
+	if s.lastSaveTime.After(s.LastModifiedTime) {
+		// nothing to do
+		return nil
+	}
+
+	// remove objects which will not be discovered again
+	s.pruneObjects()
+
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	// ensure the target file path is valid
+	if s.jsonPath == "" {
+		return fmt.Errorf("collection state path is not set")
+	}
+
+	// if we are empty, delete the file
+	if s.IsEmpty() {
+		err := os.Remove(s.jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete collection state file: %w", err)
+		}
+		return nil
+	}
+
+	// write the JSON data to the file, overwriting any existing data
+	err = os.WriteFile(s.jsonPath, jsonBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write collection state to file: %w", err)
+	}
+
+	// update the last save time
+	s.lastSaveTime = time.Now()
+
+	return nil
+}
+
+// pruneObjects removes objects with a timestamp before the retention window - the next collection starts from
+// the window, so these objects will not be discovered again
+// objects with no timestamp are always retained, as they are discovered by every collection
+// (the caller must hold the lock)
+func (s *HttpCollectionState[T]) pruneObjects() {
+	cutoff := s.retentionStart()
+	if cutoff.IsZero() {
+		return
+	}
+	for url, state := range s.Objects {
+		if !state.Timestamp.IsZero() && state.Timestamp.Before(cutoff) {
+			delete(s.Objects, url)
+		}
+	}
+}
+
+// IsEmpty returns whether the collection state is empty
+func (s *HttpCollectionState[T]) IsEmpty() bool {
+	return len(s.Objects) == 0
+}
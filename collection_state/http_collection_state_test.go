@@ -0,0 +1,30 @@
+package collection_state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/artifact_source_config"
+)
+
+func TestHttpCollectionState_pruneObjects(t *testing.T) {
+	s := NewHttpCollectionState[*artifact_source_config.ArtifactSourceConfigImpl]().(*HttpCollectionState[*artifact_source_config.ArtifactSourceConfigImpl])
+	s.SetGranularity(time.Hour)
+	latest := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	s.Objects = map[string]*HttpObjectState{
+		"latest": {ETag: "1", Timestamp: latest},
+		"recent": {ETag: "1", Timestamp: latest.Add(-24 * time.Hour)},
+		// the granularity is raised to the minimum of a day, so objects are kept for two days
+		"old":          {ETag: "1", Timestamp: latest.Add(-72 * time.Hour)},
+		"no timestamp": {ETag: "1"},
+	}
+	// the next collection starts from the retention window
+	assert.Equal(t, latest.Add(-48*time.Hour), s.GetEndTime())
+
+	s.pruneObjects()
+	assert.Contains(t, s.Objects, "latest")
+	assert.Contains(t, s.Objects, "recent")
+	assert.Contains(t, s.Objects, "no timestamp")
+	assert.NotContains(t, s.Objects, "old")
+}
@@ -1,8 +1,10 @@
 package collection_state
 
 import (
-	"github.com/turbot/tailpipe-plugin-sdk/parse"
 	"time"
+
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
 )
 
 type CollectionState[T parse.Config] interface {
@@ -22,4 +24,23 @@ type CollectionState[T parse.Config] interface {
 type ArtifactCollectionState[T parse.Config] interface {
 	CollectionState[T]
 	RegisterPath(path string, metadata map[string]string)
+	// ShouldCollectArtifact is used in place of ShouldCollect when the version of the artifact is known
+	// if the artifact has been collected but has since changed, ShouldCollectChanged is returned,
+	// along with the version which was collected
+	ShouldCollectArtifact(id string, timestamp time.Time, version *types.ArtifactVersion) (ShouldCollectResult, *types.ArtifactVersion)
+	// OnArtifactCollected is used in place of OnCollected when the version of the collected artifact is known
+	// the version must be that of the data which was collected, i.e. it is determined when the artifact is downloaded
+	OnArtifactCollected(id string, timestamp time.Time, version *types.ArtifactVersion) error
 }
+
+// ShouldCollectResult is the result of ArtifactCollectionState.ShouldCollectArtifact
+type ShouldCollectResult int
+
+const (
+	// ShouldCollectSkip - the artifact has already been collected
+	ShouldCollectSkip ShouldCollectResult = iota
+	// ShouldCollectNew - the artifact has not been collected
+	ShouldCollectNew
+	// ShouldCollectChanged - the artifact has been collected, but has changed since
+	ShouldCollectChanged
+)
@@ -1,3 +1,19 @@
 package constants
 
 const ArtifactSourceIdentifier = "artifact"
+
+// FileSourceIdentifier is the identifier of the local file system source provided by the SDK
+const FileSourceIdentifier = "file"
+
+// HttpSourceIdentifier is the identifier of the HTTP(S) source provided by the SDK
+const HttpSourceIdentifier = "http"
+
+// S3SourceIdentifier is the identifier of the S3 compatible object storage source provided by the SDK
+const S3SourceIdentifier = "s3"
+
+// SftpSourceIdentifier is the identifier of the SFTP source provided by the SDK
+const SftpSourceIdentifier = "sftp"
+
+// ArtifactMetadataFileLayout is the artifact metadata key containing the file layout matched by the artifact path
+// (this is one of the configured file layouts, before any optional segments are expanded)
+const ArtifactMetadataFileLayout = "file_layout"
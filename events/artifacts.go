@@ -1,6 +1,8 @@
 package events
 
 import (
+	"errors"
+
 	"github.com/turbot/tailpipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/tailpipe-plugin-sdk/types"
 )
@@ -100,3 +102,83 @@ func ArtifactExtractedFromProto(e *proto.Event) Event {
 		Info:        types.DownloadedArtifactInfoFromProto(e.GetArtifactExtractedEvent().ArtifactInfo),
 	}
 }
+
+// ArtifactDownloadRetried is an event that is fired when a failed artifact download is retried
+type ArtifactDownloadRetried struct {
+	Base
+	ExecutionId string
+	Info        *types.ArtifactInfo
+	// the attempt which failed (starting at 1)
+	Attempt int
+	Err     error
+}
+
+func NewArtifactDownloadRetriedEvent(executionId string, info *types.ArtifactInfo, attempt int, err error) *ArtifactDownloadRetried {
+	return &ArtifactDownloadRetried{
+		ExecutionId: executionId,
+		Info:        info,
+		Attempt:     attempt,
+		Err:         err,
+	}
+}
+
+func (c *ArtifactDownloadRetried) ToProto() *proto.Event {
+	return &proto.Event{
+		Event: &proto.Event_ArtifactDownloadRetriedEvent{
+			ArtifactDownloadRetriedEvent: &proto.EventArtifactDownloadRetried{
+				ExecutionId:  c.ExecutionId,
+				ArtifactInfo: c.Info.ToProto(),
+				Attempt:      int32(c.Attempt),
+				Error:        c.Err.Error(),
+			},
+		},
+	}
+}
+
+func ArtifactDownloadRetriedFromProto(e *proto.Event) Event {
+	retried := e.GetArtifactDownloadRetriedEvent()
+	return &ArtifactDownloadRetried{
+		ExecutionId: retried.ExecutionId,
+		Info:        types.ArtifactInfoFromProto(retried.ArtifactInfo),
+		Attempt:     int(retried.Attempt),
+		Err:         errors.New(retried.Error),
+	}
+}
+
+// ArtifactError is an event that is fired when an artifact cannot be collected
+// (for example because the downloaded artifact failed integrity verification)
+type ArtifactError struct {
+	Base
+	ExecutionId string
+	Info        *types.ArtifactInfo
+	Err         error
+}
+
+func NewArtifactErrorEvent(executionId string, info *types.ArtifactInfo, err error) *ArtifactError {
+	return &ArtifactError{
+		ExecutionId: executionId,
+		Info:        info,
+		Err:         err,
+	}
+}
+
+func (c *ArtifactError) ToProto() *proto.Event {
+	return &proto.Event{
+		Event: &proto.Event_ArtifactErrorEvent{
+			ArtifactErrorEvent: &proto.EventArtifactError{
+				ExecutionId:  c.ExecutionId,
+				ArtifactInfo: c.Info.ToProto(),
+				Error:        c.Err.Error(),
+			},
+		},
+	}
+}
+
+func ArtifactErrorFromProto(e *proto.Event) Event {
+	artifactErr := e.GetArtifactErrorEvent()
+	return &ArtifactError{
+		ExecutionId: artifactErr.ExecutionId,
+		Info:        types.ArtifactInfoFromProto(artifactErr.ArtifactInfo),
+		Err:         errors.New(artifactErr.Error),
+	}
+}
@@ -15,6 +15,12 @@ func SourceEventFromProto(e *proto.Event) (Event, error) {
 		return ArtifactExtractedFromProto(e), nil
 	case *proto.Event_ArtifactDownloadedEvent:
 		return ArtifactDownloadedFromProto(e), nil
+	case *proto.Event_ArtifactDownloadRetriedEvent:
+		return ArtifactDownloadRetriedFromProto(e), nil
+	case *proto.Event_ArtifactErrorEvent:
+		return ArtifactErrorFromProto(e), nil
+	case *proto.Event_SourceStatusEvent:
+		return SourceStatusFromProto(e), nil
 	case *proto.Event_SourceCompleteEvent:
 		return SourceCompleteFromProto(e), nil
 	default:
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"github.com/turbot/tailpipe-plugin-sdk/grpc/proto"
+)
+
+// SourceStatus is an event that is fired when the state of a source changes
+// (as opposed to the counts of artifacts and rows, which are derived from the other source events)
+type SourceStatus struct {
+	Base
+	ExecutionId string
+	// the disk space used by downloaded artifacts in the temp directory
+	TempDirBytes int64
+	// the temp directory disk budget (zero if there is no budget)
+	TempDirMaxBytes int64
+	// the effective download rate limit, in downloads per second (zero if there is no rate limit)
+	// if the download limiter is adaptive, this may be below the configured limit
+	DownloadRateLimit float64
+	// the effective download concurrency limit (zero if there is no concurrency limit)
+	DownloadConcurrencyLimit int64
+}
+
+func NewSourceStatusEvent(executionId string) *SourceStatus {
+	return &SourceStatus{
+		ExecutionId: executionId,
+	}
+}
+
+func (c *SourceStatus) ToProto() *proto.Event {
+	return &proto.Event{
+		Event: &proto.Event_SourceStatusEvent{
+			SourceStatusEvent: &proto.EventSourceStatus{
+				ExecutionId:              c.ExecutionId,
+				TempDirBytes:             c.TempDirBytes,
+				TempDirMaxBytes:          c.TempDirMaxBytes,
+				DownloadRateLimit:        c.DownloadRateLimit,
+				DownloadConcurrencyLimit: c.DownloadConcurrencyLimit,
+			},
+		},
+	}
+}
+
+func SourceStatusFromProto(e *proto.Event) Event {
+	status := e.GetSourceStatusEvent()
+	return &SourceStatus{
+		ExecutionId:              status.ExecutionId,
+		TempDirBytes:             status.TempDirBytes,
+		TempDirMaxBytes:          status.TempDirMaxBytes,
+		DownloadRateLimit:        status.DownloadRateLimit,
+		DownloadConcurrencyLimit: status.DownloadConcurrencyLimit,
+	}
+}
@@ -15,12 +15,18 @@ type Status struct {
 	ArtifactsDownloaded      int64
 	ArtifactsDownloadedBytes int64 // *
 	ArtifactsExtracted       int64
-	ArtifactErrors           int64 // *
+	ArtifactErrors           int64
+	ArtifactDownloadRetries  int64
 	RowsReceived             int64
 	RowsEnriched             int64
+	RowsDeduplicated         int64
 	Errors                   int64
+	TempDirBytes             int64
+	TempDirMaxBytes          int64
+	DownloadRateLimit        float64
+	DownloadConcurrencyLimit int64
 
-	// we only need the mutex when updating string fields (i.e. LatestArtifactLocation)
+	// we only need the mutex when updating string and float fields (i.e. LatestArtifactLocation, DownloadRateLimit)
 	// we use atomic operations for all int fields
 	mut sync.Mutex
 }
@@ -41,9 +47,15 @@ func (r *Status) ToProto() *proto.Event {
 				ArtifactsDownloadedBytes: r.ArtifactsDownloadedBytes,
 				ArtifactsExtracted:       r.ArtifactsExtracted,
 				ArtifactErrors:           r.ArtifactErrors,
+				ArtifactDownloadRetries:  r.ArtifactDownloadRetries,
 				RowsReceived:             r.RowsReceived,
 				RowsEnriched:             r.RowsEnriched,
+				RowsDeduplicated:         r.RowsDeduplicated,
 				Errors:                   r.Errors,
+				TempDirBytes:             r.TempDirBytes,
+				TempDirMaxBytes:          r.TempDirMaxBytes,
+				DownloadRateLimit:        r.DownloadRateLimit,
+				DownloadConcurrencyLimit: r.DownloadConcurrencyLimit,
 			},
 		},
 	}
@@ -59,18 +71,34 @@ func (r *Status) Update(event Event) {
 	case *ArtifactDownloaded:
 		atomic.AddInt64(&r.ArtifactsDownloaded, 1)
 		atomic.AddInt64(&r.ArtifactsDownloadedBytes, t.Info.Size)
+	case *ArtifactDownloadRetried:
+		atomic.AddInt64(&r.ArtifactDownloadRetries, 1)
+	case *ArtifactError:
+		atomic.AddInt64(&r.ArtifactErrors, 1)
 	case *ArtifactExtracted:
 		atomic.AddInt64(&r.ArtifactsExtracted, 1)
 	case *RowExtracted:
 		atomic.AddInt64(&r.RowsReceived, 1)
 	case *Error:
 		atomic.AddInt64(&r.Errors, 1)
+	case *SourceStatus:
+		atomic.StoreInt64(&r.TempDirBytes, t.TempDirBytes)
+		atomic.StoreInt64(&r.TempDirMaxBytes, t.TempDirMaxBytes)
+		atomic.StoreInt64(&r.DownloadConcurrencyLimit, t.DownloadConcurrencyLimit)
+		r.mut.Lock()
+		r.DownloadRateLimit = t.DownloadRateLimit
+		r.mut.Unlock()
 	}
 }
 func (r *Status) OnRowEnriched() {
 	atomic.AddInt64(&r.RowsEnriched, 1)
 }
 
+// OnRowDeduplicated is called when a row is dropped as a duplicate of a previously collected row
+func (r *Status) OnRowDeduplicated() {
+	atomic.AddInt64(&r.RowsDeduplicated, 1)
+}
+
 func (r *Status) Equals(status *Status) bool {
 	if status == nil {
 		return false
@@ -78,8 +106,14 @@ func (r *Status) Equals(status *Status) bool {
 
 	return r.ArtifactsDiscovered == status.ArtifactsDiscovered &&
 		r.ArtifactsDownloaded == status.ArtifactsDownloaded &&
+		r.ArtifactDownloadRetries == status.ArtifactDownloadRetries &&
 		r.ArtifactsExtracted == status.ArtifactsExtracted &&
+		r.ArtifactErrors == status.ArtifactErrors &&
 		r.RowsEnriched == status.RowsEnriched &&
-		r.Errors == status.Errors
+		r.RowsDeduplicated == status.RowsDeduplicated &&
+		r.Errors == status.Errors &&
+		r.TempDirBytes == status.TempDirBytes &&
+		r.DownloadRateLimit == status.DownloadRateLimit &&
+		r.DownloadConcurrencyLimit == status.DownloadConcurrencyLimit
 
 }
@@ -2,13 +2,18 @@ package helpers
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/turbot/pipe-fittings/v2/filter"
 )
 
 // BuildFilterMap parses the provided filter strings and returns a map of field name to SQL filters.
+// If there are multiple filters for a field, they are combined, i.e. all must be satisfied
 // Note: this will fail if any filter refers to more than one field
 func BuildFilterMap(filterString []string) (map[string]*filter.SqlFilter, error) {
 	filters := make(map[string]*filter.SqlFilter)
+	// the filter strings for each field, used to combine multiple filters for the same field
+	fieldFilterStrings := make(map[string][]string)
 	for _, filterString := range filterString {
 		// Create a new SQL filter
 		f, err := filter.NewSqlFilter(filterString)
@@ -29,7 +34,20 @@ func BuildFilterMap(filterString []string) (map[string]*filter.SqlFilter, error)
 		}
 
 		// Map the filter to its field name
-		filters[fieldNames[0]] = f
+		fieldName := fieldNames[0]
+		fieldFilterStrings[fieldName] = append(fieldFilterStrings[fieldName], filterString)
+		if len(fieldFilterStrings[fieldName]) == 1 {
+			filters[fieldName] = f
+			continue
+		}
+
+		// there are multiple filters for this field - combine them
+		combined := "(" + strings.Join(fieldFilterStrings[fieldName], ") and (") + ")"
+		f, err = filter.NewSqlFilter(combined)
+		if err != nil {
+			return nil, fmt.Errorf("failed to combine filters for %s: %v", fieldName, err)
+		}
+		filters[fieldName] = f
 	}
 	return filters, nil
 }
@@ -15,3 +15,10 @@ type Config interface {
 type DynamicTableConfig interface {
 	GetSchema() *schema.RowSchema
 }
+
+// DecodeResolver is an optional interface which a configuration struct may implement to resolve values which
+// cannot be decoded directly into a typed field (e.g. an attribute which may be either a string or a list)
+// ResolveDecoded is called once the HCL has been decoded, before the config is validated
+type DecodeResolver interface {
+	ResolveDecoded() error
+}
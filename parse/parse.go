@@ -28,6 +28,21 @@ func ParseConfig[T types.Config](configData types.ConfigData) (T, error) {
 		return target, fmt.Errorf("invalid %s type '%s': expected '%s'", configData.GetConfigType(), configData.Identifier(), id)
 	}
 
+	return decodeConfig(configData, target)
+}
+
+// ParseConfigBody parses the HCL config and returns the struct
+// Unlike ParseConfig, it does NOT verify that the ConfigData identifier matches the identifier of the target
+// - this is used for config which is common to all identifiers of a config type (e.g. partition config)
+func ParseConfigBody[T types.Config](configData types.ConfigData) (T, error) {
+	// Create a new instance of the target struct
+	target := utils.InstanceOf[T]()
+
+	return decodeConfig(configData, target)
+}
+
+// decodeConfig parses the HCL config into the target struct
+func decodeConfig[T types.Config](configData types.ConfigData, target T) (T, error) {
 	// Parse the config
 	declRange := configData.GetRange()
 	hclBytes := configData.GetHcl()
@@ -51,6 +66,13 @@ func ParseConfig[T types.Config](configData types.ConfigData) (T, error) {
 		return target, error_helpers.HclDiagsToError(fmt.Sprintf("Failed to decode %s config", configData.GetConfigType()), diags)
 	}
 
+	// give the config the chance to resolve any values which could not be decoded directly
+	if resolver, ok := any(target).(DecodeResolver); ok {
+		if err := resolver.ResolveDecoded(); err != nil {
+			return target, fmt.Errorf("failed to decode %s config: %w", configData.GetConfigType(), err)
+		}
+	}
+
 	// Return the struct by value
 	return target, nil
 }
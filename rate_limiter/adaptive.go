@@ -0,0 +1,163 @@
+package rate_limiter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// the minimum interval between decreases - throttling responses to requests which were made before
+	// the limits were last decreased should not decrease them again
+	adaptiveDecreaseInterval = time.Second
+	// each round of successful requests increases the rate by this fraction of the configured rate
+	adaptiveRateIncreaseDivisor = 10
+	// the rate is never decreased below this fraction of the configured rate
+	adaptiveMinRateDivisor = 64
+)
+
+// adaptiveLimits adjusts the rate and concurrency of an APILimiter using AIMD (additive increase,
+// multiplicative decrease): the limits are halved when a request is throttled, and increased for each
+// round of successful requests, up to the configured limits
+type adaptiveLimits struct {
+	mut sync.Mutex
+
+	// the configured limits - these are the upper bounds
+	maxRate        rate.Limit
+	maxConcurrency int64
+
+	// the current concurrency limit and the number of requests in progress
+	concurrency int64
+	active      int64
+	// the number of successful requests since the limits were last changed
+	successes int64
+
+	lastDecrease time.Time
+	// if the server asked us to retry after a delay, no requests are started until then
+	pausedUntil time.Time
+
+	// closed (and replaced) when a request completes or the concurrency is increased, to wake waiting requests
+	changed chan struct{}
+}
+
+func newAdaptiveLimits(maxRate rate.Limit, maxConcurrency int64) *adaptiveLimits {
+	return &adaptiveLimits{
+		maxRate:        maxRate,
+		maxConcurrency: maxConcurrency,
+		concurrency:    maxConcurrency,
+		changed:        make(chan struct{}),
+	}
+}
+
+// acquire blocks until any pause has elapsed and there is capacity for another request
+func (a *adaptiveLimits) acquire(ctx context.Context) error {
+	for {
+		a.mut.Lock()
+		if pause := time.Until(a.pausedUntil); pause > 0 {
+			a.mut.Unlock()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(pause):
+			}
+			continue
+		}
+		if a.maxConcurrency == 0 || a.active < a.concurrency {
+			a.active++
+			a.mut.Unlock()
+			return nil
+		}
+		changed := a.changed
+		a.mut.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (a *adaptiveLimits) tryAcquire() bool {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	if time.Now().Before(a.pausedUntil) || (a.maxConcurrency != 0 && a.active >= a.concurrency) {
+		return false
+	}
+	a.active++
+	return true
+}
+
+func (a *adaptiveLimits) release() {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	a.active--
+	a.signal()
+}
+
+// onThrottled halves the limits and pauses new requests for retryAfter (if set)
+// returns whether the limits were changed
+func (a *adaptiveLimits) onThrottled(limiter *rate.Limiter, retryAfter time.Duration) bool {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	now := time.Now()
+	if retryAfter > 0 && now.Add(retryAfter).After(a.pausedUntil) {
+		a.pausedUntil = now.Add(retryAfter)
+	}
+	a.successes = 0
+	if now.Sub(a.lastDecrease) < adaptiveDecreaseInterval {
+		return false
+	}
+	a.lastDecrease = now
+
+	if a.concurrency > 1 {
+		a.concurrency /= 2
+	}
+	if limiter != nil {
+		limiter.SetLimit(max(limiter.Limit()/2, a.maxRate/adaptiveMinRateDivisor))
+	}
+	return true
+}
+
+// onSuccess increases the limits once a full round of requests (i.e. the current concurrency) has succeeded
+// returns whether the limits were changed
+func (a *adaptiveLimits) onSuccess(limiter *rate.Limiter) bool {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	a.successes++
+	if a.successes < max(a.concurrency, 1) {
+		return false
+	}
+	a.successes = 0
+
+	changed := false
+	if a.concurrency < a.maxConcurrency {
+		a.concurrency++
+		a.signal()
+		changed = true
+	}
+	if limiter != nil && limiter.Limit() < a.maxRate {
+		limiter.SetLimit(min(limiter.Limit()+a.maxRate/adaptiveRateIncreaseDivisor, a.maxRate))
+		changed = true
+	}
+	return changed
+}
+
+func (a *adaptiveLimits) getConcurrency() int64 {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	return a.concurrency
+}
+
+// signal wakes any waiting requests - the mutex must be held
+func (a *adaptiveLimits) signal() {
+	close(a.changed)
+	a.changed = make(chan struct{})
+}
@@ -0,0 +1,57 @@
+package rate_limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestAPILimiter_adaptive(t *testing.T) {
+	l := NewAPILimiter(&Definition{Name: "test", FillRate: 100, BucketSize: 100, MaxConcurrency: 8, Adaptive: true})
+
+	// throttling halves the limits - but only once per decrease interval
+	assert.True(t, l.OnThrottled(0))
+	assert.False(t, l.OnThrottled(0))
+	limit, concurrency := l.EffectiveLimits()
+	assert.Equal(t, rate.Limit(50), limit)
+	assert.Equal(t, int64(4), concurrency)
+
+	// the reduced concurrency is enforced
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.False(t, l.TryToAcquireSemaphore())
+	for i := 0; i < 4; i++ {
+		l.Release()
+	}
+
+	// a round of successful requests increases the limits again
+	for i := 0; i < 3; i++ {
+		assert.False(t, l.OnSuccess())
+	}
+	assert.True(t, l.OnSuccess())
+	limit, concurrency = l.EffectiveLimits()
+	assert.Equal(t, rate.Limit(60), limit)
+	assert.Equal(t, int64(5), concurrency)
+
+	// a retry after hint pauses new requests
+	l.OnThrottled(50 * time.Millisecond)
+	assert.False(t, l.TryToAcquireSemaphore())
+	start := time.Now()
+	assert.NoError(t, l.Wait(ctx))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	l.Release()
+}
+
+func TestAPILimiter_notAdaptive(t *testing.T) {
+	l := NewAPILimiter(&Definition{Name: "test", MaxConcurrency: 8})
+	assert.False(t, l.OnThrottled(time.Second))
+	assert.False(t, l.OnSuccess())
+	limit, concurrency := l.EffectiveLimits()
+	assert.Equal(t, rate.Limit(0), limit)
+	assert.Equal(t, int64(8), concurrency)
+}
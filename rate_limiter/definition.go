@@ -14,18 +14,46 @@ type Definition struct {
 	BucketSize int64
 	// the max concurrency supported
 	MaxConcurrency int64
+	// if set, the rate and concurrency are decreased when requests are throttled, and increased again
+	// (up to the configured limits) as requests succeed
+	Adaptive bool
+	// the scope keys (e.g. connection, region, endpoint) - when a limiter is shared using the Registry,
+	// a separate limiter is created for each distinct combination of scope values
+	Scope []string
+}
+
+// NewBandwidthDefinition returns the definition of a limiter which limits throughput to the given bytes per second
+// (with a burst of one second) - tokens are acquired for each byte, using WaitN (see also NewLimitedReader)
+func NewBandwidthDefinition(name string, bytesPerSecond int64) *Definition {
+	return &Definition{
+		Name:       name,
+		FillRate:   rate.Limit(bytesPerSecond),
+		BucketSize: bytesPerSecond,
+	}
 }
 
 func (d *Definition) String() string {
 	limiterString := ""
 	concurrencyString := ""
-	if d.FillRate >= 0 {
+	if d.FillRate > 0 {
 		limiterString = fmt.Sprintf("Limit(/s): %v, Burst: %d", d.FillRate, d.BucketSize)
 	}
-	if d.MaxConcurrency >= 0 {
+	if d.MaxConcurrency > 0 {
 		concurrencyString = fmt.Sprintf("MaxConcurrency: %d", d.MaxConcurrency)
 	}
-	return strings.Join([]string{limiterString, concurrencyString}, " ")
+	if d.Adaptive {
+		concurrencyString += " Adaptive"
+	}
+	return strings.TrimSpace(strings.Join([]string{limiterString, concurrencyString}, " "))
+}
+
+// scopeKey returns the key identifying the limiter instance for the given scope values
+func (d *Definition) scopeKey(scopeValues ScopeValues) string {
+	parts := make([]string, len(d.Scope))
+	for i, k := range d.Scope {
+		parts[i] = fmt.Sprintf("%s=%q", k, scopeValues[k])
+	}
+	return strings.Join(parts, ",")
 }
 
 func (d *Definition) Validate() []string {
@@ -36,6 +64,13 @@ func (d *Definition) Validate() []string {
 	if (d.FillRate == 0 || d.BucketSize == 0) && d.MaxConcurrency == 0 {
 		validationErrors = append(validationErrors, "rate limiter definition must definer either a rate limit or max concurrency")
 	}
+	scopeKeys := make(map[string]struct{}, len(d.Scope))
+	for _, k := range d.Scope {
+		if _, ok := scopeKeys[k]; ok || k == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf("rate limiter definition has an empty or duplicate scope key '%s'", k))
+		}
+		scopeKeys[k] = struct{}{}
+	}
 
 	return validationErrors
 }
@@ -0,0 +1,34 @@
+package rate_limiter
+
+import (
+	"context"
+	"io"
+)
+
+// limitedReader is a reader which waits for a token from the limiter for each byte read
+type limitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *APILimiter
+}
+
+// NewLimitedReader returns a reader which limits the throughput of the underlying reader using the limiter
+// (which is usually a bandwidth limiter, see NewBandwidthDefinition)
+// if the limiter is nil, the reader is returned unchanged
+func NewLimitedReader(ctx context.Context, r io.Reader, limiter *APILimiter) io.Reader {
+	if limiter == nil {
+		return r
+	}
+	return &limitedReader{ctx: ctx, r: r, limiter: limiter}
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		// wait for the bytes which have been read - this delays the next read until the throughput is within the limit
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,33 @@
+package rate_limiter
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLimitedReader(t *testing.T) {
+	l := NewAPILimiter(NewBandwidthDefinition("bandwidth", 10000))
+	data := make([]byte, 15000)
+
+	// the first second of data is allowed as a burst, the remainder is limited to 10000 bytes/s
+	start := time.Now()
+	n, err := io.Copy(io.Discard, NewLimitedReader(context.Background(), bytes.NewReader(data), l))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), n)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	// cancelling the context aborts the read
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = io.Copy(io.Discard, NewLimitedReader(ctx, bytes.NewReader(data), l))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// a nil limiter does not limit the reader
+	r := bytes.NewReader(data)
+	assert.Same(t, r, NewLimitedReader(context.Background(), r, nil))
+}
@@ -6,6 +6,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/time/rate"
 	"strings"
+	"time"
 )
 
 type APILimiter struct {
@@ -16,6 +17,9 @@ type APILimiter struct {
 	// semaphore to control concurrency
 	sem            *semaphore.Weighted
 	maxConcurrency int64
+	// if the limiter is adaptive, this controls concurrency (instead of the semaphore) and adjusts the limits
+	// in response to throttling
+	adaptive *adaptiveLimits
 }
 
 func NewAPILimiter(l *Definition) *APILimiter {
@@ -26,7 +30,9 @@ func NewAPILimiter(l *Definition) *APILimiter {
 	if l.FillRate != 0 {
 		res.limiter = rate.NewLimiter(l.FillRate, int(l.BucketSize))
 	}
-	if l.MaxConcurrency != 0 {
+	if l.Adaptive {
+		res.adaptive = newAdaptiveLimits(l.FillRate, l.MaxConcurrency)
+	} else if l.MaxConcurrency != 0 {
 		res.sem = semaphore.NewWeighted(l.MaxConcurrency)
 	}
 	return res
@@ -35,16 +41,23 @@ func NewAPILimiter(l *Definition) *APILimiter {
 func (l *APILimiter) String() string {
 	limiterString := ""
 	concurrencyString := ""
+	adaptiveString := ""
 	if l.limiter != nil {
 		limiterString = fmt.Sprintf("Limit(/s): %v, Burst: %d", l.limiter.Limit(), l.limiter.Burst())
 	}
 	if l.maxConcurrency >= 0 {
 		concurrencyString = fmt.Sprintf("MaxConcurrency: %d", l.maxConcurrency)
 	}
-	return strings.Join([]string{limiterString, concurrencyString}, " ")
+	if l.adaptive != nil {
+		adaptiveString = "Adaptive"
+	}
+	return strings.Join([]string{limiterString, concurrencyString, adaptiveString}, " ")
 }
 
 func (l *APILimiter) acquireSemaphore(ctx context.Context) error {
+	if l.adaptive != nil {
+		return l.adaptive.acquire(ctx)
+	}
 	if l.sem == nil {
 		return nil
 	}
@@ -52,6 +65,9 @@ func (l *APILimiter) acquireSemaphore(ctx context.Context) error {
 }
 
 func (l *APILimiter) TryToAcquireSemaphore() bool {
+	if l.adaptive != nil {
+		return l.adaptive.tryAcquire()
+	}
 	if l.sem == nil {
 		return true
 	}
@@ -68,10 +84,69 @@ func (l *APILimiter) Wait(ctx context.Context) error {
 	return nil
 }
 
+// WaitN waits for n tokens from the rate limiter (for example a byte count, for a bandwidth limiter)
+// unlike Wait, this does not acquire the concurrency semaphore, so there is nothing to release
+// if n exceeds the burst size, the tokens are acquired in burst sized chunks
+// an error is returned if the limiter has a burst of zero, as no tokens can ever be granted
+func (l *APILimiter) WaitN(ctx context.Context, n int) error {
+	if l.limiter == nil || n <= 0 || l.limiter.Limit() == rate.Inf {
+		return nil
+	}
+	burst := l.limiter.Burst()
+	if burst <= 0 {
+		return fmt.Errorf("rate limiter %s has a bucket size of zero, so cannot grant %d tokens", l.Name, n)
+	}
+	for n > 0 {
+		chunk := min(n, burst)
+		if err := l.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
 func (l *APILimiter) Release() {
+	if l.adaptive != nil {
+		l.adaptive.release()
+		return
+	}
 	if l.sem == nil {
 		return
 	}
 	l.sem.Release(1)
 
 }
+
+// OnThrottled is called when a request was throttled by the server, with the delay the server asked for
+// (zero if none) - if the limiter is adaptive, the limits are decreased
+// returns whether the limits were changed
+func (l *APILimiter) OnThrottled(retryAfter time.Duration) bool {
+	if l.adaptive == nil {
+		return false
+	}
+	return l.adaptive.onThrottled(l.limiter, retryAfter)
+}
+
+// OnSuccess is called when a request succeeded - if the limiter is adaptive, the limits are gradually increased
+// back towards the configured limits
+// returns whether the limits were changed
+func (l *APILimiter) OnSuccess() bool {
+	if l.adaptive == nil {
+		return false
+	}
+	return l.adaptive.onSuccess(l.limiter)
+}
+
+// EffectiveLimits returns the current rate limit (zero if there is no rate limit) and concurrency limit
+// (zero if there is no concurrency limit) - for an adaptive limiter, these may be below the configured limits
+func (l *APILimiter) EffectiveLimits() (rate.Limit, int64) {
+	var limit rate.Limit
+	if l.limiter != nil {
+		limit = l.limiter.Limit()
+	}
+	if l.adaptive != nil {
+		return limit, l.adaptive.getConcurrency()
+	}
+	return limit, l.maxConcurrency
+}
@@ -0,0 +1,28 @@
+package rate_limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPILimiter_WaitN(t *testing.T) {
+	ctx := context.Background()
+
+	// tokens beyond the burst size are acquired in burst sized chunks
+	l := NewAPILimiter(&Definition{Name: "test", FillRate: 1000, BucketSize: 100})
+	start := time.Now()
+	assert.NoError(t, l.WaitN(ctx, 300))
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	// a limiter with no rate limit does not wait
+	assert.NoError(t, NewAPILimiter(&Definition{Name: "test", MaxConcurrency: 1}).WaitN(ctx, 100))
+
+	// a limiter with a burst of zero can never grant tokens - this must return an error rather than hang
+	l = NewAPILimiter(&Definition{Name: "test", FillRate: 100, BucketSize: 0, MaxConcurrency: 1})
+	assert.Empty(t, (&Definition{Name: "test", FillRate: 100, BucketSize: 0, MaxConcurrency: 1}).Validate())
+	assert.Error(t, l.WaitN(ctx, 10))
+	assert.NoError(t, l.WaitN(ctx, 0))
+}
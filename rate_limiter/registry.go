@@ -0,0 +1,93 @@
+package rate_limiter
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// RegisterLimiter registers a named rate limiter definition with the global registry
+// this is called from the package init function of the plugin, so that the limiter is shared by all the
+// tables and sources in the plugin process which look it up by name (see LimiterRegistry.GetLimiter)
+func RegisterLimiter(def *Definition) {
+	Registry.registerLimiter(def)
+}
+
+// Registry is the global limiter registry - limiters are shared across all collections in the plugin process
+var Registry = newLimiterRegistry()
+
+// common scope keys
+const (
+	ScopeConnection = "connection"
+	ScopeRegion     = "region"
+	ScopeEndpoint   = "endpoint"
+)
+
+// ScopeValues are the values of the scope keys of a limiter definition (e.g. connection, region, endpoint)
+type ScopeValues map[string]string
+
+// LimiterRegistry holds named limiter definitions, and the limiter instances created for each set of scope values
+type LimiterRegistry struct {
+	mut         sync.Mutex
+	definitions map[string]*Definition
+	// limiter instances, keyed by definition name then scope key
+	limiters map[string]map[string]*APILimiter
+}
+
+func newLimiterRegistry() *LimiterRegistry {
+	return &LimiterRegistry{
+		definitions: make(map[string]*Definition),
+		limiters:    make(map[string]map[string]*APILimiter),
+	}
+}
+
+func (r *LimiterRegistry) registerLimiter(def *Definition) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	// a limiter registered again replaces the previous definition
+	r.definitions[def.Name] = def
+	delete(r.limiters, def.Name)
+}
+
+// GetLimiter returns the limiter with the given name for the given scope values
+// a limiter instance is created for each distinct combination of values of the scope keys of the definition,
+// so for example, a limiter scoped by region limits the requests made to each region separately
+// values which are not passed for a scope key are treated as empty
+func (r *LimiterRegistry) GetLimiter(name string, scopeValues ScopeValues) (*APILimiter, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	def, ok := r.definitions[name]
+	if !ok {
+		return nil, fmt.Errorf("rate limiter not registered: %s", name)
+	}
+	if validationErrors := def.Validate(); len(validationErrors) > 0 {
+		return nil, fmt.Errorf("invalid rate limiter %s: %s", name, strings.Join(validationErrors, ", "))
+	}
+
+	scopeKey := def.scopeKey(scopeValues)
+	limiters := r.limiters[name]
+	if limiters == nil {
+		limiters = make(map[string]*APILimiter)
+		r.limiters[name] = limiters
+	}
+	limiter, ok := limiters[scopeKey]
+	if !ok {
+		limiter = NewAPILimiter(def)
+		limiters[scopeKey] = limiter
+	}
+	return limiter, nil
+}
+
+// GetDefinitions returns the registered limiter definitions, keyed by name
+func (r *LimiterRegistry) GetDefinitions() map[string]*Definition {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	res := make(map[string]*Definition, len(r.definitions))
+	for k, v := range r.definitions {
+		res[k] = v
+	}
+	return res
+}
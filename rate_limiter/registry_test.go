@@ -0,0 +1,29 @@
+package rate_limiter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterRegistry_GetLimiter(t *testing.T) {
+	r := newLimiterRegistry()
+	r.registerLimiter(&Definition{Name: "api", MaxConcurrency: 2, Scope: []string{ScopeConnection, ScopeRegion}})
+	r.registerLimiter(&Definition{Name: "invalid"})
+
+	l1, err := r.GetLimiter("api", ScopeValues{ScopeConnection: "c1", ScopeRegion: "us-east-1"})
+	assert.NoError(t, err)
+	// the same scope values share a limiter - values for keys which are not in the scope are ignored
+	l2, err := r.GetLimiter("api", ScopeValues{ScopeConnection: "c1", ScopeRegion: "us-east-1", ScopeEndpoint: "e1"})
+	assert.NoError(t, err)
+	assert.Same(t, l1, l2)
+	// different scope values have separate limiters
+	l3, err := r.GetLimiter("api", ScopeValues{ScopeConnection: "c1", ScopeRegion: "eu-west-1"})
+	assert.NoError(t, err)
+	assert.NotSame(t, l1, l3)
+
+	_, err = r.GetLimiter("missing", nil)
+	assert.Error(t, err)
+	_, err = r.GetLimiter("invalid", nil)
+	assert.Error(t, err)
+}
@@ -111,9 +111,12 @@ func IsArtifactSource(sourceType string) bool {
 	// we cannot reference artifact_source here as it would create a circular dependency
 	// for now use a map of known types
 	artifactSources := map[string]struct{}{
-		"aws_s3_bucket":      {},
-		"file":               {},
-		"gcp_storage_bucket": {},
+		"aws_s3_bucket":                {},
+		constants.FileSourceIdentifier: {},
+		constants.HttpSourceIdentifier: {},
+		constants.S3SourceIdentifier:   {},
+		constants.SftpSourceIdentifier: {},
+		"gcp_storage_bucket":           {},
 	}
 	_, ok := artifactSources[sourceType]
 	return ok
@@ -2,6 +2,7 @@ package row_source
 
 import (
 	"context"
+	"time"
 
 	"github.com/turbot/tailpipe-plugin-sdk/observable"
 )
@@ -40,6 +41,14 @@ type RowSource interface {
 	GetFromTime() *ResolvedFromTime
 }
 
+// ContinuousRowSource is implemented by sources which may collect continuously until the context is cancelled,
+// for example an artifact source in watch mode.
+// The collector writes buffered rows and saves the collection state at the returned interval
+// - a zero interval means the source is not collecting continuously
+type ContinuousRowSource interface {
+	GetFlushInterval() time.Duration
+}
+
 // BaseSource registers the rowSource implementation with the base struct (_before_ calling Init)
 // we do not want to expose this function in the RowSource interface
 type BaseSource interface {
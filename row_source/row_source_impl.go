@@ -40,6 +40,10 @@ type RowSourceImpl[S, T parse.Config] struct {
 	FromTime time.Time
 	// how was from time set (config, collection state, default)
 	FromTimeSource string
+	// the end time for the data collection - if zero, data is collected up to the present
+	// if set, this is a windowed collection (e.g. a backfill of a historic period) - the collection state is
+	// neither loaded nor saved, so the end time used by incremental collections is not affected
+	ToTime time.Time
 }
 
 // RegisterSource is called by the source implementation to register itself with the base
@@ -72,11 +76,19 @@ func (r *RowSourceImpl[S, T]) Init(_ context.Context, params *RowSourceParams, o
 		return err
 	}
 
+	r.ToTime = params.To
+
 	// create empty collection state
 	slog.Info("Creating empty collection state")
 	r.CollectionState = r.NewCollectionStateFunc()
 	// initialise the collection state - this will load itself form json (if JSON file exists)
-	err = r.CollectionState.Init(r.Config, params.CollectionStatePath)
+	// for a windowed collection, use the empty state (with no path) so the persisted state is left untouched
+	collectionStatePath := params.CollectionStatePath
+	if r.isWindowed() {
+		slog.Info("Windowed collection - the collection state will not be loaded or saved", "from", params.From, "to", r.ToTime)
+		collectionStatePath = ""
+	}
+	err = r.CollectionState.Init(r.Config, collectionStatePath)
 	if err != nil {
 		return err
 	}
@@ -84,19 +96,40 @@ func (r *RowSourceImpl[S, T]) Init(_ context.Context, params *RowSourceParams, o
 	// and falling back to the collection state/default value if needed
 	r.setFromTime(params)
 
+	if !r.ToTime.IsZero() && r.ToTime.Before(r.FromTime) {
+		return fmt.Errorf("collection end time %s is before the start time %s", r.ToTime.Format(time.RFC3339), r.FromTime.Format(time.RFC3339))
+	}
+
 	return nil
 }
 
+// isWindowed returns whether this collection has an end time, i.e. it collects a specific (historic) time window
+func (r *RowSourceImpl[S, T]) isWindowed() bool {
+	return !r.ToTime.IsZero()
+}
+
 func (r *RowSourceImpl[S, T]) setFromTime(params *RowSourceParams) {
 	if !params.From.IsZero() {
 		// just set the collection state end time
 		r.FromTime = params.From
 		r.FromTimeSource = ""
+		// a windowed collection does not update the collection state
+		if r.isWindowed() {
+			return
+		}
 		// set the end tim of the collection state to the DAY BEFORE from time
 		// the from time has a day granularity - we want to collect data up to the end of the day before
 		r.CollectionState.SetEndTime(params.From.Add(-time.Hour * 24))
 		return
 	}
+	// a windowed collection does not use the collection state - default the from time relative to the end time
+	// (rather than to now, which may be after the end time)
+	if r.isWindowed() {
+		r.FromTime = r.ToTime.Add(-constants.DefaultInitialCollectionPeriod)
+		r.FromTimeSource = fmt.Sprintf("windowed collection, default %d days before end time", int(constants.DefaultInitialCollectionPeriod.Hours()/24))
+		slog.Info("Setting from time relative to end time", "from", r.FromTime, "to", r.ToTime)
+		return
+	}
 	// if no from time was passed, set it to the end time of the collection state
 	if !r.CollectionState.IsEmpty() {
 		t := r.CollectionState.GetEndTime()
@@ -117,6 +150,10 @@ func (r *RowSourceImpl[S, T]) setFromTime(params *RowSourceParams) {
 }
 
 func (r *RowSourceImpl[S, T]) SaveCollectionState() error {
+	// a windowed collection must not overwrite the state of incremental collections
+	if r.isWindowed() {
+		return nil
+	}
 	return r.CollectionState.Save()
 }
 
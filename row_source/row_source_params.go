@@ -12,6 +12,7 @@ type RowSourceParams struct {
 	ConnectionData      *types.ConnectionConfigData
 	CollectionStatePath string
 	From                time.Time
+	To                  time.Time
 	CollectionTempDir   string
 }
 
@@ -21,6 +22,9 @@ func (r *RowSourceParams) AsProto() *proto.RowSourceParams {
 		FromTime:            timestamppb.New(r.From),
 		CollectionTempDir:   r.CollectionTempDir,
 	}
+	if !r.To.IsZero() {
+		res.ToTime = timestamppb.New(r.To)
+	}
 	if r.SourceConfigData != nil {
 		res.SourceData = r.SourceConfigData.AsProto()
 	}
@@ -36,6 +40,9 @@ func RowSourceParamsFromProto(pr *proto.RowSourceParams) (*RowSourceParams, erro
 		From:                pr.FromTime.AsTime(),
 		CollectionTempDir:   pr.CollectionTempDir,
 	}
+	if pr.ToTime != nil {
+		res.To = pr.ToTime.AsTime()
+	}
 
 	if pr.SourceData != nil {
 		sourceData, err := types.ConfigDataFromProto[*types.SourceConfigData](pr.SourceData)
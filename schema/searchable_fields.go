@@ -0,0 +1,206 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// candidate patterns - every candidate is validated by a parser before it is accepted
+var (
+	arnCandidateRegex    = regexp.MustCompile(`arn:[a-z0-9-]+:[a-zA-Z0-9-]+:[a-z0-9-]*:[0-9]*:[^\s"',;\]\)\}]+`)
+	emailCandidateRegex  = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,63}`)
+	ipv4CandidateRegex   = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	ipv6CandidateRegex   = regexp.MustCompile(`[0-9a-fA-F]{0,4}(?::[0-9a-fA-F]{0,4}){2,7}`)
+	domainCandidateRegex = regexp.MustCompile(`\b(?:[a-zA-Z0-9](?:[a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}\b`)
+)
+
+// SearchableValues contains the searchable values extracted from row content
+type SearchableValues struct {
+	Ips     []string
+	Domains []string
+	Emails  []string
+	Akas    []string
+}
+
+// ExtractSearchableValues extracts IP addresses, domains, email addresses and ARNs from the given strings
+// Each candidate value is validated before being accepted, and the results are de-duplicated
+func ExtractSearchableValues(values ...string) *SearchableValues {
+	res := &SearchableValues{}
+	for _, v := range values {
+		res.extract(v)
+	}
+	return res
+}
+
+func (s *SearchableValues) extract(value string) {
+	if value == "" {
+		return
+	}
+
+	// ARNs - extract these first and remove them from the value, as they contain account ids and
+	// resource names which may otherwise be mistaken for other values
+	for _, arn := range arnCandidateRegex.FindAllString(value, -1) {
+		if isValidArn(arn) {
+			s.Akas = appendUnique(s.Akas, arn)
+		}
+	}
+	value = arnCandidateRegex.ReplaceAllString(value, " ")
+
+	// emails - also add the email domain to the domains
+	for _, candidate := range emailCandidateRegex.FindAllString(value, -1) {
+		addr, err := mail.ParseAddress(candidate)
+		if err != nil || addr.Address != candidate {
+			continue
+		}
+		s.Emails = appendUnique(s.Emails, candidate)
+		if domain := candidate[strings.LastIndex(candidate, "@")+1:]; isValidDomain(domain) {
+			s.Domains = appendUnique(s.Domains, strings.ToLower(domain))
+		}
+	}
+	value = emailCandidateRegex.ReplaceAllString(value, " ")
+
+	// IP addresses
+	for _, candidate := range ipv4CandidateRegex.FindAllString(value, -1) {
+		if addr, err := netip.ParseAddr(candidate); err == nil {
+			s.Ips = appendUnique(s.Ips, addr.String())
+		}
+	}
+	value = ipv4CandidateRegex.ReplaceAllString(value, " ")
+	for _, candidate := range ipv6CandidateRegex.FindAllString(value, -1) {
+		if addr, err := netip.ParseAddr(candidate); err == nil && addr.Is6() {
+			s.Ips = appendUnique(s.Ips, addr.String())
+		}
+	}
+
+	// domains
+	for _, candidate := range domainCandidateRegex.FindAllString(value, -1) {
+		if isValidDomain(candidate) {
+			s.Domains = appendUnique(s.Domains, strings.ToLower(candidate))
+		}
+	}
+}
+
+// isValidArn returns whether the string is a well-formed ARN, i.e. arn:partition:service:region:account-id:resource
+func isValidArn(arn string) bool {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return false
+	}
+	partition, service, accountId, resource := parts[1], parts[2], parts[4], parts[5]
+	if !strings.HasPrefix(partition, "aws") || service == "" || resource == "" {
+		return false
+	}
+	// account id is either empty (e.g. S3 buckets) or 12 digits
+	return accountId == "" || len(accountId) == 12
+}
+
+// isValidDomain returns whether the string is a domain with a public suffix managed by ICANN
+// (this excludes strings with a dotted format which are not domains, e.g. file names)
+func isValidDomain(domain string) bool {
+	domain = strings.ToLower(domain)
+	suffix, icann := publicsuffix.PublicSuffix(domain)
+	// the domain must have at least one label in addition to the suffix
+	return icann && suffix != domain
+}
+
+// Merge merges the extracted values into the common fields, de-duplicating against any existing values
+func (s *SearchableValues) Merge(c *CommonFields) {
+	for _, v := range s.Ips {
+		c.TpIps = appendUnique(c.TpIps, v)
+	}
+	for _, v := range s.Domains {
+		c.TpDomains = appendUnique(c.TpDomains, v)
+	}
+	for _, v := range s.Emails {
+		c.TpEmails = appendUnique(c.TpEmails, v)
+	}
+	for _, v := range s.Akas {
+		c.TpAkas = appendUnique(c.TpAkas, v)
+	}
+}
+
+// AddSearchableValues extracts IP addresses, domains, email addresses and ARNs from the given strings
+// and merges them into the searchable fields (tp_ips, tp_domains, tp_emails, tp_akas)
+func (c *CommonFields) AddSearchableValues(values ...string) {
+	ExtractSearchableValues(values...).Merge(c)
+}
+
+// AddSearchableValuesFromRow extracts IP addresses, domains, email addresses and ARNs from the given columns of the row
+// and merges them into the searchable fields (tp_ips, tp_domains, tp_emails, tp_akas)
+// The columns are the JSON names of the row fields - if no columns are specified, all string columns
+// (other than the tp_ columns) are used
+// This is intended to be called from Table.EnrichRow implementations, e.g.
+//
+//	row.CommonFields.AddSearchableValuesFromRow(row, "src_endpoint", "user_agent")
+func (c *CommonFields) AddSearchableValuesFromRow(row any, columns ...string) error {
+	rowMap, err := rowAsStringMap(row)
+	if err != nil {
+		return err
+	}
+	c.AddSearchableValues(SearchableColumnValues(rowMap, columns...)...)
+	return nil
+}
+
+// SearchableColumnValues returns the values of the given columns from a row map
+// If no columns are specified, the values of all columns other than the tp_ columns are returned
+func SearchableColumnValues(rowMap map[string][]string, columns ...string) []string {
+	if len(columns) == 0 {
+		for col := range rowMap {
+			if !strings.HasPrefix(col, "tp_") {
+				columns = append(columns, col)
+			}
+		}
+		// sort so the order of the extracted values is deterministic
+		slices.Sort(columns)
+	}
+	var res []string
+	for _, col := range columns {
+		res = append(res, rowMap[col]...)
+	}
+	return res
+}
+
+// rowAsStringMap converts the row into a map of column name to the string values of the column
+// (a string column has a single value, a string list column may have many, non-string columns are skipped)
+func rowAsStringMap(row any) (map[string][]string, error) {
+	jsonBytes, err := json.Marshal(row)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling row: %w", err)
+	}
+	var rowMap map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&rowMap); err != nil {
+		return nil, fmt.Errorf("error unmarshalling row: %w", err)
+	}
+
+	res := make(map[string][]string, len(rowMap))
+	for k, v := range rowMap {
+		switch t := v.(type) {
+		case string:
+			res[k] = []string{t}
+		case []any:
+			for _, e := range t {
+				if s, ok := e.(string); ok {
+					res[k] = append(res[k], s)
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractSearchableValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   *SearchableValues
+	}{
+		{
+			name:   "ipv4 and ipv6",
+			values: []string{"connection from 10.0.0.1 to 2001:db8::1 port 443", "10.0.0.1"},
+			want: &SearchableValues{
+				Ips: []string{"10.0.0.1", "2001:db8::1"},
+			},
+		},
+		{
+			name:   "invalid ips are ignored",
+			values: []string{"version 999.1.1.1 at 12:34:56"},
+			want:   &SearchableValues{},
+		},
+		{
+			name:   "email also adds domain",
+			values: []string{"user Alice@Example.com logged in"},
+			want: &SearchableValues{
+				Emails:  []string{"Alice@Example.com"},
+				Domains: []string{"example.com"},
+			},
+		},
+		{
+			name:   "domains require a public suffix",
+			values: []string{"GET https://www.turbot.com/index.html from api.example.co.uk", "config.json"},
+			want: &SearchableValues{
+				Domains: []string{"www.turbot.com", "api.example.co.uk"},
+			},
+		},
+		{
+			name:   "arns",
+			values: []string{`{"arn":"arn:aws:iam::123456789012:user/alice","bucket":"arn:aws:s3:::my-bucket"}`, "arn:aws:iam::123:user/bad"},
+			want: &SearchableValues{
+				Akas: []string{"arn:aws:iam::123456789012:user/alice", "arn:aws:s3:::my-bucket"},
+			},
+		},
+		{
+			name:   "empty",
+			values: []string{""},
+			want:   &SearchableValues{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractSearchableValues(tt.values...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommonFields_AddSearchableValuesFromRow(t *testing.T) {
+	type row struct {
+		CommonFields
+		SrcAddr   string   `json:"src_addr"`
+		UserEmail string   `json:"user_email"`
+		Hosts     []string `json:"hosts"`
+		Count     int      `json:"count"`
+	}
+	r := row{
+		CommonFields: CommonFields{
+			TpIps: []string{"10.0.0.1"},
+		},
+		SrcAddr:   "10.0.0.1",
+		UserEmail: "bob@example.org",
+		Hosts:     []string{"db.example.net", "192.168.1.1"},
+		Count:     5,
+	}
+
+	// all columns
+	c := r.CommonFields
+	assert.NoError(t, c.AddSearchableValuesFromRow(r))
+	assert.Equal(t, []string{"10.0.0.1", "192.168.1.1"}, c.TpIps)
+	assert.Equal(t, []string{"db.example.net", "example.org"}, c.TpDomains)
+	assert.Equal(t, []string{"bob@example.org"}, c.TpEmails)
+
+	// specified columns only
+	c = CommonFields{}
+	assert.NoError(t, c.AddSearchableValuesFromRow(r, "src_addr"))
+	assert.Equal(t, []string{"10.0.0.1"}, c.TpIps)
+	assert.Empty(t, c.TpDomains)
+	assert.Empty(t, c.TpEmails)
+}
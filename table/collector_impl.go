@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/turbot/tailpipe-plugin-sdk/events"
 	"github.com/turbot/tailpipe-plugin-sdk/filepaths"
 	"github.com/turbot/tailpipe-plugin-sdk/observable"
+	"github.com/turbot/tailpipe-plugin-sdk/parse"
 	"github.com/turbot/tailpipe-plugin-sdk/row_source"
 	"github.com/turbot/tailpipe-plugin-sdk/schema"
 	"github.com/turbot/tailpipe-plugin-sdk/types"
@@ -58,6 +62,17 @@ type CollectorImpl[R types.RowStruct] struct {
 	chunkCountMap map[string]int
 
 	writer ChunkWriter
+
+	// the partition level collection options
+	partitionConfig *PartitionConfig
+	// if deduplication is enabled, this will be set
+	deduplicator *rowDeduplicator
+	// stages applied to each enriched row before it is buffered (e.g. redaction)
+	rowTransforms []rowTransform
+	// the time window of a windowed collection (i.e. one with an end time) - rows outside the window are dropped
+	// these are zero if the collection is not windowed
+	windowFrom time.Time
+	windowTo   time.Time
 }
 
 func (c *CollectorImpl[R]) Init(ctx context.Context, req *types.CollectRequest) error {
@@ -66,6 +81,15 @@ func (c *CollectorImpl[R]) Init(ctx context.Context, req *types.CollectRequest)
 	if err := c.initSource(ctx, req); err != nil {
 		return err
 	}
+	// for a windowed collection, the window starts at the resolved from time of the source
+	if !req.To.IsZero() {
+		c.windowFrom = c.source.GetFromTime().Time
+		c.windowTo = req.To
+	}
+
+	if err := c.initPartitionConfig(req.PartitionData); err != nil {
+		return err
+	}
 
 	// if the plugin overrides this function it must call the base implementation
 	c.rowBufferMap = make(map[string][]any)
@@ -74,6 +98,7 @@ func (c *CollectorImpl[R]) Init(ctx context.Context, req *types.CollectRequest)
 	// get JSONL path
 	jsonPath, err := filepaths.EnsureJSONLPath(req.CollectionTempDir)
 	if err != nil {
+		c.closeRowTransforms()
 		return fmt.Errorf("error getting JSONL path: %w", err)
 	}
 	// create writer
@@ -89,6 +114,47 @@ func (c *CollectorImpl[R]) Identifier() string {
 
 // GetSchema returns the schema of the table
 func (c *CollectorImpl[R]) GetSchema() (*schema.RowSchema, error) {
+	s, err := c.getRowSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	// add the columns and description of any row transforms, remove any columns they drop
+	// and change the type of any columns they convert to strings
+	for _, t := range c.rowTransforms {
+		s.Columns = append(s.Columns, t.schemaColumns()...)
+		dropped, converted := t.droppedColumns(), t.stringColumns()
+		if len(dropped) > 0 || len(converted) > 0 {
+			var columns []*schema.ColumnSchema
+			for _, col := range s.Columns {
+				if slices.Contains(dropped, col.ColumnName) {
+					continue
+				}
+				if slices.Contains(converted, col.ColumnName) {
+					col = stringColumn(col)
+				}
+				columns = append(columns, col)
+			}
+			s.Columns = columns
+		}
+		s.Description = strings.TrimSpace(s.Description + "\n" + t.describe())
+	}
+	return s, nil
+}
+
+// stringColumn returns a copy of the column schema with the type changed to VARCHAR (or VARCHAR[] for list columns)
+func stringColumn(col *schema.ColumnSchema) *schema.ColumnSchema {
+	res := *col
+	res.Type = "VARCHAR"
+	if strings.HasSuffix(col.Type, "[]") {
+		res.Type = "VARCHAR[]"
+	}
+	res.StructFields = nil
+	return &res
+}
+
+// getRowSchema returns the schema of the table row, before any row transforms are applied
+func (c *CollectorImpl[R]) getRowSchema() (*schema.RowSchema, error) {
 	rowStruct := utils.InstanceOf[R]()
 
 	// if the table has a dynamic row, we can only return the schema is the config supports it
@@ -125,6 +191,19 @@ func (c *CollectorImpl[R]) Collect(ctx context.Context) (int, int, error) {
 
 	// create empty status event
 	c.status = events.NewStatusEvent(c.req.ExecutionId)
+	// close any row transforms once collection is complete
+	defer c.closeRowTransforms()
+
+	// if the source collects continuously (e.g. an artifact source in watch mode), periodically write buffered rows
+	// and save the collection state while the source is collecting
+	var flushInterval time.Duration
+	if continuousSource, ok := c.source.(row_source.ContinuousRowSource); ok {
+		flushInterval = continuousSource.GetFlushInterval()
+	}
+	if flushInterval > 0 {
+		stopFlush := c.startPeriodicFlush(ctx, flushInterval)
+		defer stopFlush()
+	}
 
 	// tell our source to collect
 	// this is a blocking call, but we will receive and process row events during the execution
@@ -133,6 +212,11 @@ func (c *CollectorImpl[R]) Collect(ctx context.Context) (int, int, error) {
 		return 0, 0, err
 	}
 
+	// a continuous source stops collecting when the context is cancelled - we must still write the remaining rows
+	if flushInterval > 0 {
+		ctx = context.WithoutCancel(ctx)
+	}
+
 	slog.Info("Source collection complete - waiting for enrichment")
 
 	// wait for all rows to be processed
@@ -188,6 +272,7 @@ func (c *CollectorImpl[R]) initSource(ctx context.Context, req *types.CollectReq
 		ConnectionData:      req.ConnectionData,
 		CollectionStatePath: req.CollectionStatePath,
 		From:                req.From,
+		To:                  req.To,
 		CollectionTempDir:   req.CollectionTempDir,
 	}
 
@@ -209,6 +294,192 @@ func (c *CollectorImpl[R]) initSource(ctx context.Context, req *types.CollectReq
 	return c.source.AddObserver(c)
 }
 
+// initPartitionConfig parses the partition level collection options and initialises any configured row stages
+func (c *CollectorImpl[R]) initPartitionConfig(partitionData *types.PartitionConfigData) (err error) {
+	// if a stage fails to initialise, close any row transforms which have already been added
+	// (e.g. open GeoIP databases) - Collect will not be called, so they would never be closed
+	defer func() {
+		if err != nil {
+			c.closeRowTransforms()
+			c.rowTransforms = nil
+		}
+	}()
+
+	// default to empty config
+	c.partitionConfig = &PartitionConfig{}
+	if partitionData != nil && len(partitionData.GetHcl()) > 0 {
+		partitionConfig, err := parse.ParseConfigBody[*PartitionConfig](partitionData)
+		if err != nil {
+			return fmt.Errorf("error parsing partition config: %w", err)
+		}
+		c.partitionConfig = partitionConfig
+	}
+
+	if err := c.partitionConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid partition config: %w", err)
+	}
+
+	if dedupConfig := c.partitionConfig.Dedup; dedupConfig != nil {
+		if err := c.validateColumnsExist(dedupConfig.GetColumns()); err != nil {
+			return fmt.Errorf("invalid dedup config: %w", err)
+		}
+		c.deduplicator = newRowDeduplicator(dedupConfig)
+		slog.Info("Row deduplication enabled", "columns", dedupConfig.GetColumns(), "max_entries", dedupConfig.GetMaxEntries())
+	}
+
+	if geoIPConfig := c.partitionConfig.GeoIP; geoIPConfig != nil {
+		if err := c.validateColumnsExist(geoIPConfig.GetColumns(), "VARCHAR"); err != nil {
+			return fmt.Errorf("invalid geoip config: %w", err)
+		}
+		geoIPEnricher, err := newGeoIPEnricher(geoIPConfig)
+		if err != nil {
+			return err
+		}
+		if err := c.addRowTransform(geoIPEnricher); err != nil {
+			_ = geoIPEnricher.Close()
+			return fmt.Errorf("invalid geoip config: %w", err)
+		}
+		slog.Info("GeoIP enrichment enabled", "columns", geoIPConfig.GetColumns())
+	}
+
+	for _, lookupConfig := range c.partitionConfig.Lookup {
+		if err := c.validateColumnsExist([]string{lookupConfig.Column}); err != nil {
+			return fmt.Errorf("invalid lookup config: %w", err)
+		}
+		lookupEnricher, err := newLookupEnricher(lookupConfig, c.listColumns("tp_tags", "tp_akas"))
+		if err != nil {
+			return err
+		}
+		if err := c.addRowTransform(lookupEnricher); err != nil {
+			return fmt.Errorf("invalid lookup config: %w", err)
+		}
+		slog.Info("Lookup enrichment enabled", "file", lookupConfig.File, "column", lookupConfig.Column, "entries", len(lookupEnricher.entries))
+	}
+
+	// NOTE: redaction is applied last, so that lookups use the unredacted values and columns added by
+	// enrichment stages may be redacted
+	if redactConfigs := c.partitionConfig.Redact; len(redactConfigs) > 0 {
+		for _, r := range redactConfigs {
+			var allowedTypes []string
+			// all actions except drop produce string values
+			if r.Action != RedactActionDrop {
+				allowedTypes = []string{"VARCHAR", "VARCHAR[]"}
+			}
+			if err := c.validateColumnsExist(r.Columns, allowedTypes...); err != nil {
+				return fmt.Errorf("invalid redact config: %w", err)
+			}
+		}
+		redactor, err := newRedactor(redactConfigs)
+		if err != nil {
+			return fmt.Errorf("invalid redact config: %w", err)
+		}
+		if err := c.addRowTransform(redactor); err != nil {
+			return fmt.Errorf("invalid redact config: %w", err)
+		}
+		slog.Info("Row redaction enabled", "rules", len(redactConfigs))
+	}
+	return nil
+}
+
+// addRowTransform adds a row transform, verifying that any columns it adds do not clash with existing columns
+func (c *CollectorImpl[R]) addRowTransform(t rowTransform) error {
+	if addedColumns := t.schemaColumns(); len(addedColumns) > 0 {
+		existingColumns := make(map[string]struct{})
+		if s, err := c.GetSchema(); err == nil && s != nil {
+			for _, col := range s.Columns {
+				existingColumns[col.ColumnName] = struct{}{}
+			}
+		}
+		for _, col := range addedColumns {
+			if _, ok := existingColumns[col.ColumnName]; ok {
+				return fmt.Errorf("column %s already exists", col.ColumnName)
+			}
+		}
+	}
+	c.rowTransforms = append(c.rowTransforms, t)
+	return nil
+}
+
+// closeRowTransforms closes any row transforms which hold resources (e.g. open database files)
+func (c *CollectorImpl[R]) closeRowTransforms() {
+	for _, t := range c.rowTransforms {
+		if closer, ok := t.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				slog.Error("error closing row transform", "error", err)
+			}
+		}
+	}
+}
+
+// applyRowTransforms applies any configured row transforms to the row
+// if there are no transforms, the row is returned as is, otherwise the transformed row map is returned
+func (c *CollectorImpl[R]) applyRowTransforms(row R) (any, error) {
+	if len(c.rowTransforms) == 0 {
+		return row, nil
+	}
+
+	rowMap, err := rowAsMap(row)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range c.rowTransforms {
+		if err := t.transform(rowMap); err != nil {
+			return nil, err
+		}
+	}
+	return rowMap, nil
+}
+
+// listColumns returns those of the given columns which have an array type in the table schema
+// dynamic rows store every column as a string (with list values comma separated), so have no list columns
+func (c *CollectorImpl[R]) listColumns(columns ...string) []string {
+	if _, ok := any(utils.InstanceOf[R]()).(*DynamicRow); ok {
+		return nil
+	}
+	s, err := c.getRowSchema()
+	if err != nil || s == nil {
+		return nil
+	}
+	schemaColumns := s.AsMap()
+	var res []string
+	for _, col := range columns {
+		if columnSchema, ok := schemaColumns[col]; ok && strings.HasSuffix(columnSchema.Type, "[]") {
+			res = append(res, col)
+		}
+	}
+	return res
+}
+
+// validateColumnsExist checks the given columns are defined in the table schema
+// if allowedTypes is non-empty, it also checks the columns are of one of the allowed types
+// if the schema is not available, or the schema automaps source fields, we cannot validate so return nil
+func (c *CollectorImpl[R]) validateColumnsExist(columns []string, allowedTypes ...string) error {
+	// NOTE: use GetSchema so columns added by existing row transforms are included
+	s, err := c.GetSchema()
+	if err != nil || s == nil || s.AutoMapSourceFields {
+		return nil
+	}
+	schemaColumns := s.AsMap()
+	var missing, invalid []string
+	for _, col := range columns {
+		columnSchema, ok := schemaColumns[col]
+		if !ok {
+			missing = append(missing, col)
+			continue
+		}
+		if len(allowedTypes) > 0 && !slices.Contains(allowedTypes, columnSchema.Type) {
+			invalid = append(invalid, fmt.Sprintf("%s (%s)", col, columnSchema.Type))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("table %s does not have columns: %s", c.Table.Identifier(), strings.Join(missing, ", "))
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("columns must be of type %s: %s", strings.Join(allowedTypes, " or "), strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
 func (c *CollectorImpl[R]) getSourceMetadata(sourceConfig *types.SourceConfigData) (sourceMetadata *SourceMetadata[R], err error) {
 	// get the supported sources for the table
 	supportedSourceMap := c.getSourceMetadataMap()
@@ -287,14 +558,53 @@ func (c *CollectorImpl[R]) handleRowExtractedEvent(ctx context.Context, e *event
 	if err != nil {
 		return err
 	}
+
+	// if this is a custom table which is configured to populate searchable fields, do so
+	if customTable := c.req.CustomTable; customTable != nil && customTable.SearchableFields != nil {
+		if d, ok := any(enrichedRow).(*DynamicRow); ok {
+			d.AddSearchableValues(customTable.SearchableFields.Columns...)
+		}
+	}
 	// validate that the enriched row has required fields
 	if err := enrichedRow.Validate(); err != nil {
 		// TODO #errors we need to include the raw row information in the error
 		return err
 	}
 
+	// artifacts are selected by their timestamp, so may contain rows from either side of the collection window
+	if c.isOutsideWindow(enrichedRow) {
+		return nil
+	}
+
+	// if deduplication is enabled, drop rows we have already seen
+	if c.deduplicator != nil {
+		duplicate, err := c.deduplicator.isDuplicate(enrichedRow)
+		if err != nil {
+			return err
+		}
+		if duplicate {
+			c.status.OnRowDeduplicated()
+			return nil
+		}
+	}
+
+	// apply any row transforms (e.g. redaction) - this must be done before the row is buffered
+	transformedRow, err := c.applyRowTransforms(enrichedRow)
+	if err != nil {
+		return err
+	}
+
 	// buffer the enriched row and write to JSON file if buffer is full
-	return c.onRowEnriched(ctx, enrichedRow)
+	return c.onRowEnriched(ctx, transformedRow)
+}
+
+// isOutsideWindow returns whether this is a windowed collection and the row timestamp is outside the window
+func (c *CollectorImpl[R]) isOutsideWindow(row R) bool {
+	if c.windowTo.IsZero() {
+		return false
+	}
+	timestamp := row.GetCommonFields().TpTimestamp
+	return timestamp.After(c.windowTo) || timestamp.Before(c.windowFrom)
 }
 
 // mapRow applies any configured mappers to the raw rows
@@ -321,7 +631,7 @@ func (c *CollectorImpl[R]) mapRow(ctx context.Context, rawRow any) (R, error) {
 }
 
 // onRowEnriched is called when a row has been enriched - it buffers the row and writes to JSONL file if buffer is full
-func (c *CollectorImpl[R]) onRowEnriched(ctx context.Context, row R) error {
+func (c *CollectorImpl[R]) onRowEnriched(ctx context.Context, row any) error {
 	executionId, err := context_values.ExecutionIdFromContext(ctx)
 	if err != nil {
 		return err
@@ -344,49 +654,98 @@ func (c *CollectorImpl[R]) onRowEnriched(ctx context.Context, row R) error {
 	c.rowCountMap[executionId] = rowCount
 
 	var rowsToWrite []any
+	var chunkNumber int
 	if len(c.rowBufferMap[executionId]) == JSONLChunkSize {
-		rowsToWrite = c.rowBufferMap[executionId]
-		c.rowBufferMap[executionId] = nil
+		rowsToWrite, chunkNumber = c.takeChunk(executionId)
 	}
 	c.rowBufferLock.Unlock()
 
 	if numRowsToWrite := len(rowsToWrite); numRowsToWrite > 0 {
-		return c.writeChunk(ctx, rowCount, rowsToWrite)
+		return c.writeChunk(ctx, chunkNumber, rowsToWrite)
 	}
 
 	return nil
 }
 
+// takeChunk removes the buffered rows for the execution and allocates the next chunk number for them
+// NOTE: rowBufferLock must be held by the caller
+func (c *CollectorImpl[R]) takeChunk(executionId string) ([]any, int) {
+	rows := c.rowBufferMap[executionId]
+	c.rowBufferMap[executionId] = nil
+	// chunks are numbered sequentially from 1
+	// (chunks may be partial if rows are flushed periodically, so the number cannot be derived from the row count)
+	c.chunkCountMap[executionId]++
+	return rows, c.chunkCountMap[executionId]
+}
+
 // writeChunk writes a chunk of rows to a JSONL file
-func (c *CollectorImpl[R]) writeChunk(ctx context.Context, rowCount int, rowsToWrite []any) error {
-	executionId, err := context_values.ExecutionIdFromContext(ctx)
+func (c *CollectorImpl[R]) writeChunk(ctx context.Context, chunkNumber int, rowsToWrite []any) error {
+	slog.Debug("writing chunk to JSONL file", "chunk", chunkNumber, "rows", len(rowsToWrite))
+
+	// convert row to a JSONL file
+	err := c.writer.WriteChunk(ctx, rowsToWrite, chunkNumber)
 	if err != nil {
-		return err
+		slog.Error("failed to write JSONL file", "error", err)
+		return fmt.Errorf("failed to write JSONL file: %w", err)
 	}
 
-	// determine chunk number from rowCountMap
-	chunkNumber := rowCount / JSONLChunkSize
+	// notify observers, passing the collection state data
+	return c.OnChunk(ctx, chunkNumber)
+}
 
-	// check for final partial chunk
-	if rowCount%JSONLChunkSize > 0 {
-		chunkNumber++
+// startPeriodicFlush starts a goroutine which writes any buffered rows and saves the collection state
+// at the given interval - returns a function to stop it
+func (c *CollectorImpl[R]) startPeriodicFlush(ctx context.Context, interval time.Duration) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.flushRows(ctx); err != nil {
+					slog.Error("CollectorImpl: error flushing rows", "error", err)
+					c.NotifyError(ctx, c.req.ExecutionId, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
 	}
-	slog.Debug("writing chunk to JSONL file", "chunk", chunkNumber, "rows", len(rowsToWrite))
+}
 
-	// convert row to a JSONL file
-	err = c.writer.WriteChunk(ctx, rowsToWrite, chunkNumber)
+// flushRows writes any buffered rows as a (partial) chunk and saves the collection state
+func (c *CollectorImpl[R]) flushRows(ctx context.Context) error {
+	executionId, err := context_values.ExecutionIdFromContext(ctx)
 	if err != nil {
-		slog.Error("failed to write JSONL file", "error", err)
-		return fmt.Errorf("failed to write JSONL file: %w", err)
+		return err
 	}
 
-	// increment the chunk count
 	c.rowBufferLock.Lock()
-	c.chunkCountMap[executionId]++
+	var rowsToWrite []any
+	var chunkNumber int
+	if len(c.rowBufferMap[executionId]) > 0 {
+		rowsToWrite, chunkNumber = c.takeChunk(executionId)
+	}
 	c.rowBufferLock.Unlock()
 
-	// notify observers, passing the collection state data
-	return c.OnChunk(ctx, chunkNumber)
+	if len(rowsToWrite) > 0 {
+		// writing the chunk also saves the collection state
+		return c.writeChunk(ctx, chunkNumber, rowsToWrite)
+	}
+	if err := c.source.SaveCollectionState(); err != nil {
+		return fmt.Errorf("error saving collection state: %w", err)
+	}
+	return nil
 }
 
 // OnChunk is called by the we have written a chunk of enriched rows to a [JSONL/CSV] file
@@ -416,7 +775,11 @@ func (c *CollectorImpl[R]) WriteRemainingRows(ctx context.Context, executionId s
 	// get row count and the rows in the buffers
 	c.rowBufferLock.Lock()
 	rowCount := c.rowCountMap[executionId]
-	rowsToWrite := c.rowBufferMap[executionId]
+	var rowsToWrite []any
+	var chunkNumber int
+	if len(c.rowBufferMap[executionId]) > 0 {
+		rowsToWrite, chunkNumber = c.takeChunk(executionId)
+	}
 	chunksWritten := c.chunkCountMap[executionId]
 	delete(c.rowBufferMap, executionId)
 	delete(c.rowCountMap, executionId)
@@ -425,11 +788,10 @@ func (c *CollectorImpl[R]) WriteRemainingRows(ctx context.Context, executionId s
 
 	// tell our write to write any remaining rows
 	if len(rowsToWrite) > 0 {
-		if err := c.writeChunk(ctx, rowCount, rowsToWrite); err != nil {
+		if err := c.writeChunk(ctx, chunkNumber, rowsToWrite); err != nil {
 			slog.Error("failed to write final chunk", "error", err)
 			return 0, 0, fmt.Errorf("failed to write final chunk: %w", err)
 		}
-		chunksWritten++
 	}
 
 	return rowCount, chunksWritten, nil
@@ -0,0 +1,130 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/tailpipe-plugin-sdk/context_values"
+	"github.com/turbot/tailpipe-plugin-sdk/events"
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+type collectorTestRow struct {
+	schema.CommonFields
+
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+// collectorTestTable enriches rows in the same way as plugin tables - a new tp_id and tp_ingest_timestamp are
+// generated for every row
+type collectorTestTable struct{}
+
+func (*collectorTestTable) Identifier() string {
+	return "test_table"
+}
+
+func (*collectorTestTable) GetSourceMetadata() []*SourceMetadata[*collectorTestRow] {
+	return nil
+}
+
+func (*collectorTestTable) EnrichRow(row *collectorTestRow, sourceEnrichment schema.SourceEnrichment) (*collectorTestRow, error) {
+	row.CommonFields = sourceEnrichment.CommonFields
+	row.TpID = xid.New().String()
+	row.TpIngestTimestamp = time.Now()
+	row.TpTimestamp = row.Time
+	row.TpIndex = schema.DefaultIndex
+	row.TpDate = row.Time.Truncate(24 * time.Hour)
+	return row, nil
+}
+
+func newTestCollector(req *types.CollectRequest) *CollectorImpl[*collectorTestRow] {
+	req.TableName = "test_table"
+	req.PartitionName = "test"
+	return &CollectorImpl[*collectorTestRow]{
+		Table:         &collectorTestTable{},
+		req:           req,
+		status:        events.NewStatusEvent("exec"),
+		rowBufferMap:  make(map[string][]any),
+		rowCountMap:   make(map[string]int),
+		chunkCountMap: make(map[string]int),
+	}
+}
+
+// handleTestRows sends a RowExtracted event for each row and returns the rows which were buffered
+func handleTestRows(t *testing.T, c *CollectorImpl[*collectorTestRow], rows ...*collectorTestRow) []*collectorTestRow {
+	ctx := context_values.WithExecutionId(context.Background(), "exec")
+	enrichment := schema.SourceEnrichment{CommonFields: schema.CommonFields{TpSourceType: "test"}}
+	for _, row := range rows {
+		assert.NoError(t, c.handleRowExtractedEvent(ctx, events.NewRowExtractedEvent("exec", row, enrichment)))
+	}
+	var res []*collectorTestRow
+	for _, row := range c.rowBufferMap["exec"] {
+		res = append(res, row.(*collectorTestRow))
+	}
+	return res
+}
+
+func TestCollectorImpl_handleRowExtractedEvent_window(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{from.Add(-time.Second), from, from.Add(time.Hour), to, to.Add(time.Second)}
+
+	tests := []struct {
+		name       string
+		windowFrom time.Time
+		windowTo   time.Time
+		want       []time.Time
+	}{
+		{
+			name: "not windowed",
+			want: times,
+		},
+		{
+			name:       "windowed",
+			windowFrom: from,
+			windowTo:   to,
+			want:       []time.Time{from, from.Add(time.Hour), to},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCollector(&types.CollectRequest{To: tt.windowTo})
+			c.windowFrom = tt.windowFrom
+			c.windowTo = tt.windowTo
+
+			var rows []*collectorTestRow
+			for _, ts := range times {
+				rows = append(rows, &collectorTestRow{Time: ts})
+			}
+			var got []time.Time
+			for _, row := range handleTestRows(t, c, rows...) {
+				got = append(got, row.TpTimestamp)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// the same event is emitted twice - each copy is given a different tp_id and tp_ingest_timestamp by EnrichRow,
+// so one copy must be dropped by the default (row content) dedup key
+func TestCollectorImpl_handleRowExtractedEvent_dedup(t *testing.T) {
+	c := newTestCollector(&types.CollectRequest{})
+	c.deduplicator = newRowDeduplicator(&DedupConfig{})
+
+	ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := handleTestRows(t, c,
+		&collectorTestRow{Time: ts, Message: "login"},
+		&collectorTestRow{Time: ts, Message: "logout"},
+		&collectorTestRow{Time: ts, Message: "login"},
+	)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "login", got[0].Message)
+		assert.Equal(t, "logout", got[1].Message)
+	}
+	assert.Equal(t, int64(1), c.status.RowsDeduplicated)
+}
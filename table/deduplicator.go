@@ -0,0 +1,154 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+// DefaultDedupMaxEntries is the default number of keys retained by the deduplicator
+// - each key is stored as a 64 bit hash so this bounds memory usage to a few tens of MB
+const DefaultDedupMaxEntries = 1_000_000
+
+// rowDeduplicator detects rows which have already been seen in this collection
+// It retains a bounded number of key hashes - once the limit is reached the oldest keys are evicted,
+// so duplicates are only detected within a window of the most recent MaxEntries rows
+type rowDeduplicator struct {
+	// the key columns - if empty, the key is the content of the row
+	columns []string
+
+	// the set of retained key hashes
+	keys map[uint64]struct{}
+	// ring buffer of retained key hashes, in insertion order - used to evict the oldest key
+	ring []uint64
+	// the ring index of the next key to insert (and therefore the oldest key once the ring is full)
+	next     int
+	capacity int
+	mut      sync.Mutex
+}
+
+func newRowDeduplicator(config *DedupConfig) *rowDeduplicator {
+	capacity := config.GetMaxEntries()
+	return &rowDeduplicator{
+		columns:  config.GetColumns(),
+		keys:     make(map[uint64]struct{}),
+		ring:     make([]uint64, 0, min(capacity, JSONLChunkSize)),
+		capacity: capacity,
+	}
+}
+
+// isDuplicate returns whether the given row has already been seen, registering it if not
+func (d *rowDeduplicator) isDuplicate(row types.RowStruct) (bool, error) {
+	key, ok, err := d.getKey(row)
+	if err != nil {
+		return false, err
+	}
+	// if the row has no values for any of the key columns we cannot dedup it
+	if !ok {
+		return false, nil
+	}
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	if _, seen := d.keys[key]; seen {
+		return true, nil
+	}
+	d.add(key)
+	return false, nil
+}
+
+// add registers the key, evicting the oldest key if we are at capacity
+// NOTE: must be called with the mutex held
+func (d *rowDeduplicator) add(key uint64) {
+	if len(d.ring) < d.capacity {
+		d.ring = append(d.ring, key)
+	} else {
+		delete(d.keys, d.ring[d.next])
+		d.ring[d.next] = key
+	}
+	d.next = (d.next + 1) % d.capacity
+	d.keys[key] = struct{}{}
+}
+
+// getKey builds the hash of the key column values for the row
+// if no key columns are configured, the hash of the row content (all columns other than the tp_ common fields) is used
+// it returns false if none of the key columns have a value
+func (d *rowDeduplicator) getKey(row types.RowStruct) (uint64, bool, error) {
+	h := fnv.New64a()
+
+	// fast path for a tp_id key
+	if len(d.columns) == 1 && d.columns[0] == "tp_id" {
+		id := row.GetCommonFields().TpID
+		if id == "" {
+			return 0, false, nil
+		}
+		_, _ = h.Write([]byte(id))
+		return h.Sum64(), true, nil
+	}
+
+	rowMap, err := rowAsMap(row)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(d.columns) == 0 {
+		return contentKey(h, rowMap)
+	}
+
+	var hasValue bool
+	for _, col := range d.columns {
+		if v, ok := rowMap[col]; ok && v != nil {
+			hasValue = true
+			if err := writeKeyValue(h, col, v); err != nil {
+				return 0, false, err
+			}
+		}
+		// write a separator so values cannot run into each other
+		_, _ = h.Write([]byte{0})
+	}
+	return h.Sum64(), hasValue, nil
+}
+
+// contentKey builds the hash of the row content - the name and value of every column other than the tp_ common fields
+// (these are either generated for each row, e.g. tp_id and tp_ingest_timestamp, or describe where the row was
+// collected from, so differ between copies of the same event)
+func contentKey(h hash.Hash64, rowMap map[string]any) (uint64, bool, error) {
+	var columns []string
+	for col, v := range rowMap {
+		if v != nil && !strings.HasPrefix(col, "tp_") {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) == 0 {
+		return 0, false, nil
+	}
+	// sort the columns so the key does not depend on map ordering
+	slices.Sort(columns)
+	for _, col := range columns {
+		_, _ = h.Write([]byte(col))
+		_, _ = h.Write([]byte{0})
+		if err := writeKeyValue(h, col, rowMap[col]); err != nil {
+			return 0, false, err
+		}
+		_, _ = h.Write([]byte{0})
+	}
+	return h.Sum64(), true, nil
+}
+
+// writeKeyValue writes the column value to the key hash
+// the JSON representation is used so non scalar values are handled deterministically
+func writeKeyValue(h hash.Hash64, col string, v any) error {
+	valueBytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error building dedup key for column '%s': %w", col, err)
+	}
+	_, _ = h.Write(valueBytes)
+	return nil
+}
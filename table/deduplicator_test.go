@@ -0,0 +1,124 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/turbot/pipe-fittings/v2/utils"
+)
+
+func TestRowDeduplicator_IsDuplicate(t *testing.T) {
+	row := func(cols map[string]string) *DynamicRow {
+		return &DynamicRow{Columns: cols}
+	}
+
+	tests := []struct {
+		name   string
+		config *DedupConfig
+		rows   []*DynamicRow
+		want   []bool
+	}{
+		{
+			name:   "default key is the row content",
+			config: &DedupConfig{},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "1", "tp_ingest_timestamp": "2025-01-01T00:00:00Z", "event_id": "e1", "region": "us-east-1"}),
+				row(map[string]string{"tp_id": "2", "tp_ingest_timestamp": "2025-01-01T00:00:01Z", "event_id": "e1", "region": "us-west-2"}),
+				row(map[string]string{"tp_id": "3", "tp_ingest_timestamp": "2025-01-01T00:00:02Z", "event_id": "e1", "region": "us-east-1"}),
+			},
+			want: []bool{false, false, true},
+		},
+		{
+			name:   "default key includes the column names",
+			config: &DedupConfig{},
+			rows: []*DynamicRow{
+				row(map[string]string{"a": "x"}),
+				row(map[string]string{"b": "x"}),
+			},
+			want: []bool{false, false},
+		},
+		{
+			name:   "rows with only common fields are never a duplicate",
+			config: &DedupConfig{},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "1"}),
+				row(map[string]string{"tp_id": "1"}),
+			},
+			want: []bool{false, false},
+		},
+		{
+			name:   "tp_id key",
+			config: &DedupConfig{Columns: []string{"tp_id"}},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "a"}),
+				row(map[string]string{"tp_id": "b"}),
+				row(map[string]string{"tp_id": "a"}),
+			},
+			want: []bool{false, false, true},
+		},
+		{
+			name:   "empty tp_id is never a duplicate",
+			config: &DedupConfig{Columns: []string{"tp_id"}},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": ""}),
+				row(map[string]string{"tp_id": ""}),
+			},
+			want: []bool{false, false},
+		},
+		{
+			name:   "multiple columns",
+			config: &DedupConfig{Columns: []string{"event_id", "region"}},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "1", "event_id": "e1", "region": "us-east-1"}),
+				row(map[string]string{"tp_id": "2", "event_id": "e1", "region": "us-west-2"}),
+				row(map[string]string{"tp_id": "3", "event_id": "e1", "region": "us-east-1"}),
+			},
+			want: []bool{false, false, true},
+		},
+		{
+			name:   "column values do not run into each other",
+			config: &DedupConfig{Columns: []string{"a", "b"}},
+			rows: []*DynamicRow{
+				row(map[string]string{"a": "xy", "b": "z"}),
+				row(map[string]string{"a": "x", "b": "yz"}),
+			},
+			want: []bool{false, false},
+		},
+		{
+			name:   "missing key columns are never a duplicate",
+			config: &DedupConfig{Columns: []string{"event_id"}},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "1"}),
+				row(map[string]string{"tp_id": "1"}),
+			},
+			want: []bool{false, false},
+		},
+		{
+			name:   "oldest keys are evicted at capacity",
+			config: &DedupConfig{Columns: []string{"tp_id"}, MaxEntries: utils.ToPointer(2)},
+			rows: []*DynamicRow{
+				row(map[string]string{"tp_id": "a"}),
+				row(map[string]string{"tp_id": "b"}),
+				row(map[string]string{"tp_id": "c"}),
+				// a has been evicted
+				row(map[string]string{"tp_id": "a"}),
+				// c is still retained
+				row(map[string]string{"tp_id": "c"}),
+			},
+			want: []bool{false, false, false, false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newRowDeduplicator(tt.config)
+			for i, r := range tt.rows {
+				got, err := d.isDuplicate(r)
+				if err != nil {
+					t.Fatalf("isDuplicate() error = %v", err)
+				}
+				if got != tt.want[i] {
+					t.Errorf("isDuplicate() row %d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -60,6 +60,26 @@ func (l *DynamicRow) Enrich(fields schema.CommonFields) {
 	}
 }
 
+// AddSearchableValues populates the searchable common fields (tp_ips, tp_domains, tp_emails, tp_akas)
+// from the values of the given columns - if no columns are specified, all non tp_ columns are used
+func (l *DynamicRow) AddSearchableValues(columns ...string) {
+	rowMap := make(map[string][]string, len(l.Columns))
+	for k, v := range l.Columns {
+		rowMap[k] = []string{v}
+	}
+
+	commonFields := l.GetCommonFields()
+	commonFields.AddSearchableValues(schema.SearchableColumnValues(rowMap, columns...)...)
+
+	// write the searchable fields back to the columns
+	commonFieldsMap := commonFields.AsMap()
+	for _, k := range []string{"tp_ips", "tp_domains", "tp_emails", "tp_akas"} {
+		if v, ok := commonFieldsMap[k]; ok {
+			l.Columns[k] = v
+		}
+	}
+}
+
 func (l *DynamicRow) Validate() error {
 	commonFields := l.GetCommonFields()
 	return commonFields.Validate()
@@ -0,0 +1,176 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+)
+
+// geoIPReader is the interface used to look up an IP address in a MaxMind database
+// (this is implemented by maxminddb.Reader)
+type geoIPReader interface {
+	Lookup(ip net.IP, result any) error
+	Close() error
+}
+
+// geoIPRecord contains the fields we read from the MaxMind City/Country and ASN databases
+type geoIPRecord struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	AutonomousSystemNumber       uint64 `maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+}
+
+// geoIPEnricher is a rowTransform which adds country, city, ASN and AS organization columns
+// for each configured IP address column
+type geoIPEnricher struct {
+	columns []string
+	// the City/Country database reader (may be nil)
+	locationReader geoIPReader
+	// the ASN database reader (may be nil)
+	asnReader geoIPReader
+}
+
+func newGeoIPEnricher(config *GeoIPConfig) (*geoIPEnricher, error) {
+	e := &geoIPEnricher{
+		columns: config.GetColumns(),
+	}
+	if config.Database != nil {
+		r, err := maxminddb.Open(*config.Database)
+		if err != nil {
+			return nil, fmt.Errorf("error opening GeoIP database %s: %w", *config.Database, err)
+		}
+		e.locationReader = r
+	}
+	if config.AsnDatabase != nil {
+		r, err := maxminddb.Open(*config.AsnDatabase)
+		if err != nil {
+			_ = e.Close()
+			return nil, fmt.Errorf("error opening ASN database %s: %w", *config.AsnDatabase, err)
+		}
+		e.asnReader = r
+	}
+	return e, nil
+}
+
+// transform implements rowTransform
+func (e *geoIPEnricher) transform(row map[string]any) error {
+	for _, col := range e.columns {
+		value, ok := row[col].(string)
+		if !ok || value == "" {
+			continue
+		}
+		ip := net.ParseIP(value)
+		if ip == nil {
+			continue
+		}
+
+		var record geoIPRecord
+		if e.locationReader != nil {
+			if err := e.locationReader.Lookup(ip, &record); err != nil {
+				return fmt.Errorf("error looking up %s in GeoIP database: %w", value, err)
+			}
+		}
+		if e.asnReader != nil {
+			if err := e.asnReader.Lookup(ip, &record); err != nil {
+				return fmt.Errorf("error looking up %s in ASN database: %w", value, err)
+			}
+		}
+
+		names := geoIPColumnNames(col)
+		if e.locationReader != nil {
+			if record.Country.IsoCode != "" {
+				row[names.country] = record.Country.IsoCode
+			}
+			if city := record.City.Names["en"]; city != "" {
+				row[names.city] = city
+			}
+		}
+		if e.asnReader != nil {
+			if record.AutonomousSystemNumber != 0 {
+				row[names.asn] = record.AutonomousSystemNumber
+			}
+			if record.AutonomousSystemOrganization != "" {
+				row[names.asOrganization] = record.AutonomousSystemOrganization
+			}
+		}
+	}
+	return nil
+}
+
+// describe implements rowTransform
+func (e *geoIPEnricher) describe() string {
+	return fmt.Sprintf("GeoIP enrichment applied to columns: %s.", strings.Join(e.columns, ", "))
+}
+
+// schemaColumns implements rowTransform
+func (e *geoIPEnricher) schemaColumns() []*schema.ColumnSchema {
+	var res []*schema.ColumnSchema
+	for _, col := range e.columns {
+		names := geoIPColumnNames(col)
+		if e.locationReader != nil {
+			res = append(res,
+				&schema.ColumnSchema{ColumnName: names.country, SourceName: names.country, Type: "VARCHAR", Description: fmt.Sprintf("The ISO country code of the %s IP address.", col)},
+				&schema.ColumnSchema{ColumnName: names.city, SourceName: names.city, Type: "VARCHAR", Description: fmt.Sprintf("The city of the %s IP address.", col)},
+			)
+		}
+		if e.asnReader != nil {
+			res = append(res,
+				&schema.ColumnSchema{ColumnName: names.asn, SourceName: names.asn, Type: "BIGINT", Description: fmt.Sprintf("The autonomous system number of the %s IP address.", col)},
+				&schema.ColumnSchema{ColumnName: names.asOrganization, SourceName: names.asOrganization, Type: "VARCHAR", Description: fmt.Sprintf("The autonomous system organization of the %s IP address.", col)},
+			)
+		}
+	}
+	return res
+}
+
+// droppedColumns implements rowTransform
+func (e *geoIPEnricher) droppedColumns() []string {
+	return nil
+}
+
+// stringColumns implements rowTransform
+func (e *geoIPEnricher) stringColumns() []string {
+	return nil
+}
+
+// Close closes the database readers
+func (e *geoIPEnricher) Close() error {
+	var errs []error
+	for _, r := range []geoIPReader{e.locationReader, e.asnReader} {
+		if r != nil {
+			if err := r.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type geoIPColumns struct {
+	country        string
+	city           string
+	asn            string
+	asOrganization string
+}
+
+// geoIPColumnNames returns the names of the columns added for the given IP address column
+// the tp_ prefix is removed from common field names, as tp_ columns are reserved,
+// e.g. tp_source_ip -> source_ip_country_code
+func geoIPColumnNames(column string) geoIPColumns {
+	base := strings.TrimPrefix(column, "tp_")
+	return geoIPColumns{
+		country:        base + "_country_code",
+		city:           base + "_city",
+		asn:            base + "_asn",
+		asOrganization: base + "_as_organization",
+	}
+}
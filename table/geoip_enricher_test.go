@@ -0,0 +1,94 @@
+package table
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGeoIPReader returns the record registered for the IP address, if any
+type fakeGeoIPReader struct {
+	records map[string]func(*geoIPRecord)
+}
+
+func (f *fakeGeoIPReader) Lookup(ip net.IP, result any) error {
+	if populate, ok := f.records[ip.String()]; ok {
+		populate(result.(*geoIPRecord))
+	}
+	return nil
+}
+
+func (f *fakeGeoIPReader) Close() error {
+	return nil
+}
+
+func TestGeoIPEnricher_Transform(t *testing.T) {
+	locationReader := &fakeGeoIPReader{records: map[string]func(*geoIPRecord){
+		"81.2.69.142": func(r *geoIPRecord) {
+			r.Country.IsoCode = "GB"
+			r.City.Names = map[string]string{"en": "London"}
+		},
+	}}
+	asnReader := &fakeGeoIPReader{records: map[string]func(*geoIPRecord){
+		"81.2.69.142": func(r *geoIPRecord) {
+			r.AutonomousSystemNumber = 20712
+			r.AutonomousSystemOrganization = "Andrews & Arnold Ltd"
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		locationReader geoIPReader
+		asnReader      geoIPReader
+		row            map[string]any
+		want           map[string]any
+	}{
+		{
+			name:           "location and asn",
+			locationReader: locationReader,
+			asnReader:      asnReader,
+			row:            map[string]any{"tp_source_ip": "81.2.69.142"},
+			want: map[string]any{
+				"tp_source_ip":              "81.2.69.142",
+				"source_ip_country_code":    "GB",
+				"source_ip_city":            "London",
+				"source_ip_asn":             uint64(20712),
+				"source_ip_as_organization": "Andrews & Arnold Ltd",
+			},
+		},
+		{
+			name:      "asn only",
+			asnReader: asnReader,
+			row:       map[string]any{"tp_source_ip": "81.2.69.142"},
+			want: map[string]any{
+				"tp_source_ip":              "81.2.69.142",
+				"source_ip_asn":             uint64(20712),
+				"source_ip_as_organization": "Andrews & Arnold Ltd",
+			},
+		},
+		{
+			name:           "unknown address",
+			locationReader: locationReader,
+			row:            map[string]any{"tp_source_ip": "10.0.0.1"},
+			want:           map[string]any{"tp_source_ip": "10.0.0.1"},
+		},
+		{
+			name:           "invalid or missing address",
+			locationReader: locationReader,
+			row:            map[string]any{"tp_source_ip": "not an ip"},
+			want:           map[string]any{"tp_source_ip": "not an ip"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &geoIPEnricher{
+				columns:        (&GeoIPConfig{}).GetColumns(),
+				locationReader: tt.locationReader,
+				asnReader:      tt.asnReader,
+			}
+			assert.NoError(t, e.transform(tt.row))
+			assert.Equal(t, tt.want, tt.row)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+)
+
+// lookupEnricher is a rowTransform which joins rows against a local lookup file,
+// adding the mapped columns and tp_tags/tp_akas values
+type lookupEnricher struct {
+	config *LookupConfig
+	// lookup entries keyed by the lookup key - each entry is a map of field name to value
+	entries map[string]map[string]string
+	// the list columns (tp_tags/tp_akas) which are written as lists - any others are written as comma separated strings
+	listColumns []string
+}
+
+// newLookupEnricher creates a lookupEnricher - listColumns are the list columns which have an array type in the
+// table schema (see CollectorImpl.listColumns)
+func newLookupEnricher(config *LookupConfig, listColumns []string) (*lookupEnricher, error) {
+	entries, err := loadLookupFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("error loading lookup file %s: %w", config.File, err)
+	}
+	return &lookupEnricher{
+		config:      config,
+		entries:     entries,
+		listColumns: listColumns,
+	}, nil
+}
+
+// transform implements rowTransform
+func (e *lookupEnricher) transform(row map[string]any) error {
+	value, ok := row[e.config.Column]
+	if !ok || value == nil {
+		return nil
+	}
+	entry, ok := e.entries[fmt.Sprint(value)]
+	if !ok {
+		return nil
+	}
+
+	for col, field := range e.config.Columns {
+		if v, ok := entry[field]; ok {
+			row[col] = v
+		}
+	}
+	appendToListColumn(row, "tp_tags", entryValues(entry, e.config.Tags), slices.Contains(e.listColumns, "tp_tags"))
+	appendToListColumn(row, "tp_akas", entryValues(entry, e.config.Akas), slices.Contains(e.listColumns, "tp_akas"))
+	return nil
+}
+
+// describe implements rowTransform
+func (e *lookupEnricher) describe() string {
+	return fmt.Sprintf("Lookup enrichment applied from %s on column %s.", filepath.Base(e.config.File), e.config.Column)
+}
+
+// schemaColumns implements rowTransform
+func (e *lookupEnricher) schemaColumns() []*schema.ColumnSchema {
+	var res []*schema.ColumnSchema
+	// sort so the schema is deterministic
+	for _, col := range slices.Sorted(maps.Keys(e.config.Columns)) {
+		res = append(res, &schema.ColumnSchema{
+			ColumnName:  col,
+			SourceName:  col,
+			Type:        "VARCHAR",
+			Description: fmt.Sprintf("The %s of the %s, from %s.", e.config.Columns[col], e.config.Column, filepath.Base(e.config.File)),
+		})
+	}
+	return res
+}
+
+// droppedColumns implements rowTransform
+func (e *lookupEnricher) droppedColumns() []string {
+	return nil
+}
+
+// stringColumns implements rowTransform
+func (e *lookupEnricher) stringColumns() []string {
+	return nil
+}
+
+// entryValues returns the non-empty values of the given fields of the lookup entry
+func entryValues(entry map[string]string, fields []string) []string {
+	var res []string
+	for _, f := range fields {
+		if v := entry[f]; v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// appendToListColumn appends values to a list column of a row map, skipping values which are already present
+// the column is always written in the same format, whatever the existing value: a list if asList is set,
+// otherwise a comma separated string (as stored by dynamic rows)
+func appendToListColumn(row map[string]any, column string, values []string, asList bool) {
+	if len(values) == 0 {
+		return
+	}
+	var list []string
+	switch existing := row[column].(type) {
+	case string:
+		if existing != "" {
+			list = strings.Split(existing, ",")
+		}
+	case []any:
+		for _, v := range existing {
+			list = append(list, fmt.Sprint(v))
+		}
+	}
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+
+	if !asList {
+		row[column] = strings.Join(list, ",")
+		return
+	}
+	res := make([]any, len(list))
+	for i, v := range list {
+		res[i] = v
+	}
+	row[column] = res
+}
+
+// loadLookupFile reads the lookup file into a map of lookup key to entry
+func loadLookupFile(config *LookupConfig) (map[string]map[string]string, error) {
+	f, err := os.Open(config.File)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.ToLower(filepath.Ext(config.File)) == ".csv" {
+		return loadLookupCsv(f, *config.Key)
+	}
+	return loadLookupJson(f, config.Key)
+}
+
+func loadLookupCsv(r io.Reader, key string) (map[string]map[string]string, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	header := records[0]
+	keyIdx := slices.Index(header, key)
+	if keyIdx == -1 {
+		return nil, fmt.Errorf("key field '%s' not found in header", key)
+	}
+
+	res := make(map[string]map[string]string, len(records)-1)
+	for _, record := range records[1:] {
+		entry := make(map[string]string, len(header))
+		for i, field := range header {
+			entry[field] = record[i]
+		}
+		res[record[keyIdx]] = entry
+	}
+	return res, nil
+}
+
+func loadLookupJson(r io.Reader, key *string) (map[string]map[string]string, error) {
+	var data any
+	decoder := json.NewDecoder(r)
+	// preserve number formatting (e.g. for numeric ids)
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]map[string]string)
+	switch d := data.(type) {
+	case map[string]any:
+		// object keyed by lookup key
+		for k, v := range d {
+			obj, ok := v.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("value for key '%s' is not an object", k)
+			}
+			res[k] = stringifyLookupEntry(obj)
+		}
+	case []any:
+		// array of objects - the key field must be specified
+		if key == nil {
+			return nil, errors.New("key must be specified for JSON lookup files containing an array")
+		}
+		for i, v := range d {
+			obj, ok := v.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("element %d is not an object", i)
+			}
+			entry := stringifyLookupEntry(obj)
+			k, ok := entry[*key]
+			if !ok {
+				return nil, fmt.Errorf("element %d has no key field '%s'", i, *key)
+			}
+			res[k] = entry
+		}
+	default:
+		return nil, errors.New("file must contain an object or an array of objects")
+	}
+	return res, nil
+}
+
+// stringifyLookupEntry converts the values of a JSON lookup entry to strings
+func stringifyLookupEntry(obj map[string]any) map[string]string {
+	entry := make(map[string]string, len(obj))
+	for k, v := range obj {
+		switch t := v.(type) {
+		case nil:
+			continue
+		case string:
+			entry[k] = t
+		case json.Number:
+			entry[k] = t.String()
+		case bool:
+			entry[k] = fmt.Sprint(t)
+		default:
+			// nested values are stored as JSON
+			b, _ := json.Marshal(t)
+			entry[k] = string(b)
+		}
+	}
+	return entry
+}
@@ -0,0 +1,180 @@
+package table
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func TestLookupEnricher_Transform(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	csvFile := writeFile("accounts.csv", "id,name,team\n123456789012,prod,platform\n210987654321,dev,\n")
+	jsonArrayFile := writeFile("accounts_array.json", `[{"id":123456789012,"name":"prod","team":"platform"}]`)
+	jsonObjectFile := writeFile("hosts.json", `{"web-1":{"owner":"web team","env":"prod"}}`)
+
+	tests := []struct {
+		name        string
+		config      *LookupConfig
+		listColumns []string
+		row         map[string]any
+		want        map[string]any
+		wantErr     bool
+	}{
+		{
+			name: "csv",
+			config: &LookupConfig{
+				File:    csvFile,
+				Column:  "account_id",
+				Key:     utils.ToPointer("id"),
+				Columns: map[string]string{"account_name": "name"},
+				Tags:    []string{"team"},
+			},
+			listColumns: []string{"tp_tags", "tp_akas"},
+			row:         map[string]any{"account_id": "123456789012", "tp_tags": []any{"existing"}},
+			want: map[string]any{
+				"account_id":   "123456789012",
+				"account_name": "prod",
+				"tp_tags":      []any{"existing", "platform"},
+			},
+		},
+		{
+			name: "csv empty tag value is skipped",
+			config: &LookupConfig{
+				File:    csvFile,
+				Column:  "account_id",
+				Key:     utils.ToPointer("id"),
+				Columns: map[string]string{"account_name": "name"},
+				Tags:    []string{"team"},
+			},
+			row:  map[string]any{"account_id": "210987654321"},
+			want: map[string]any{"account_id": "210987654321", "account_name": "dev"},
+		},
+		{
+			name: "json array with numeric key",
+			config: &LookupConfig{
+				File:    jsonArrayFile,
+				Column:  "account_id",
+				Key:     utils.ToPointer("id"),
+				Columns: map[string]string{"account_name": "name"},
+			},
+			row:  map[string]any{"account_id": json.Number("123456789012")},
+			want: map[string]any{"account_id": json.Number("123456789012"), "account_name": "prod"},
+		},
+		{
+			name: "json object with dynamic row tags",
+			config: &LookupConfig{
+				File:   jsonObjectFile,
+				Column: "host",
+				Tags:   []string{"owner", "env"},
+			},
+			row:  map[string]any{"host": "web-1", "tp_tags": "prod"},
+			want: map[string]any{"host": "web-1", "tp_tags": "prod,web team"},
+		},
+		{
+			name: "no match",
+			config: &LookupConfig{
+				File:    csvFile,
+				Column:  "account_id",
+				Key:     utils.ToPointer("id"),
+				Columns: map[string]string{"account_name": "name"},
+			},
+			row:  map[string]any{"account_id": "999"},
+			want: map[string]any{"account_id": "999"},
+		},
+		{
+			name: "json array requires key",
+			config: &LookupConfig{
+				File:    jsonArrayFile,
+				Column:  "account_id",
+				Columns: map[string]string{"account_name": "name"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "csv key not in header",
+			config: &LookupConfig{
+				File:    csvFile,
+				Column:  "account_id",
+				Key:     utils.ToPointer("account"),
+				Columns: map[string]string{"account_name": "name"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := newLookupEnricher(tt.config, tt.listColumns)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, e.transform(tt.row))
+			assert.Equal(t, tt.want, tt.row)
+		})
+	}
+}
+
+// list columns are written with the type of the column in the table schema, whether or not the row already has a value
+func TestCollectorImpl_lookupListColumns(t *testing.T) {
+	lookupFile := filepath.Join(t.TempDir(), "messages.csv")
+	if err := os.WriteFile(lookupFile, []byte("message,category\nlogin,auth\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config := &LookupConfig{File: lookupFile, Column: "message", Key: utils.ToPointer("message"), Tags: []string{"category"}}
+
+	c := newTestCollector(&types.CollectRequest{})
+	listColumns := c.listColumns("tp_tags", "tp_akas")
+	assert.Equal(t, []string{"tp_tags", "tp_akas"}, listColumns)
+	e, err := newLookupEnricher(config, listColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.rowTransforms = []rowTransform{e}
+
+	ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		row  *collectorTestRow
+		want []any
+	}{
+		{
+			// TpTags is nil so tp_tags is omitted from the row map
+			name: "nil tags",
+			row:  &collectorTestRow{Time: ts, Message: "login"},
+			want: []any{"auth"},
+		},
+		{
+			name: "existing tags",
+			row:  &collectorTestRow{Time: ts, Message: "login", CommonFields: schema.CommonFields{TpTags: []string{"existing"}}},
+			want: []any{"existing", "auth"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.applyRowTransforms(tt.row)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got.(map[string]any)["tp_tags"])
+			}
+		})
+	}
+}
+
+func TestCollectorImpl_listColumns_dynamicRow(t *testing.T) {
+	c := &CollectorImpl[*DynamicRow]{}
+	assert.Empty(t, c.listColumns("tp_tags", "tp_akas"))
+}
@@ -0,0 +1,249 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// PartitionConfig contains the partition level collection options
+// these are parsed from the partition config data passed in the CollectRequest and apply to all tables
+type PartitionConfig struct {
+	Remain hcl.Body `hcl:",remain" json:"-"`
+
+	// optional deduplication of rows within a collection
+	Dedup *DedupConfig `hcl:"dedup,block"`
+	// optional redaction rules, applied to enriched rows before they are written
+	Redact []*RedactConfig `hcl:"redact,block"`
+	// optional GeoIP/ASN enrichment of IP address columns
+	GeoIP *GeoIPConfig `hcl:"geoip,block"`
+	// optional enrichment from local lookup files
+	Lookup []*LookupConfig `hcl:"lookup,block"`
+}
+
+func (c *PartitionConfig) Validate() error {
+	var errs []error
+	if c.Dedup != nil {
+		if err := c.Dedup.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid dedup config: %w", err))
+		}
+	}
+	for i, r := range c.Redact {
+		if err := r.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid redact config %d: %w", i+1, err))
+		}
+	}
+	if c.GeoIP != nil {
+		if err := c.GeoIP.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid geoip config: %w", err))
+		}
+	}
+	for i, l := range c.Lookup {
+		if err := l.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid lookup config %d: %w", i+1, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *PartitionConfig) Identifier() string {
+	return "partition"
+}
+
+// DedupConfig defines how duplicate rows are detected and dropped
+type DedupConfig struct {
+	// the columns used to build the dedup key - if not specified, the content of the row is used
+	// (i.e. all columns other than the tp_ common fields, which are generated for each row or describe its source)
+	Columns []string `hcl:"columns,optional"`
+	// the maximum number of keys retained in memory - once this is reached, the oldest keys are evicted
+	MaxEntries *int `hcl:"max_entries,optional"`
+}
+
+func (c *DedupConfig) Validate() error {
+	for _, col := range c.Columns {
+		if col == "" {
+			return errors.New("columns must not contain empty column names")
+		}
+	}
+	if c.MaxEntries != nil && *c.MaxEntries <= 0 {
+		return fmt.Errorf("max_entries must be greater than zero, got %d", *c.MaxEntries)
+	}
+	return nil
+}
+
+// GetColumns returns the dedup key columns - if empty, the key is the content of the row
+func (c *DedupConfig) GetColumns() []string {
+	return c.Columns
+}
+
+// GetMaxEntries returns the maximum number of retained keys, defaulting to DefaultDedupMaxEntries
+func (c *DedupConfig) GetMaxEntries() int {
+	if c.MaxEntries == nil {
+		return DefaultDedupMaxEntries
+	}
+	return *c.MaxEntries
+}
+
+// redaction actions
+const (
+	// RedactActionHash replaces the value with a salted SHA-256 hash
+	RedactActionHash = "hash"
+	// RedactActionMask replaces all but the last `length` characters of the value with '*'
+	RedactActionMask = "mask"
+	// RedactActionTruncate keeps only the first `length` characters of the value
+	RedactActionTruncate = "truncate"
+	// RedactActionDrop removes the column from the row
+	RedactActionDrop = "drop"
+	// RedactActionScrub replaces all matches of the configured regex patterns within the value
+	RedactActionScrub = "scrub"
+)
+
+var redactActions = []string{RedactActionHash, RedactActionMask, RedactActionTruncate, RedactActionDrop, RedactActionScrub}
+
+// DefaultRedactReplacement is the default replacement string used by the scrub action
+const DefaultRedactReplacement = "[REDACTED]"
+
+// RedactConfig defines a redaction rule applied to one or more columns
+type RedactConfig struct {
+	// the columns to redact
+	Columns []string `hcl:"columns"`
+	// the redaction action: hash, mask, truncate, drop or scrub
+	Action string `hcl:"action"`
+	// the salt used by the hash action
+	Salt *string `hcl:"salt,optional"`
+	// for truncate, the number of characters to keep; for mask, the number of trailing characters left unmasked
+	Length *int `hcl:"length,optional"`
+	// the regex patterns used by the scrub action
+	Patterns []string `hcl:"patterns,optional"`
+	// the replacement string used by the scrub action
+	Replacement *string `hcl:"replacement,optional"`
+}
+
+func (c *RedactConfig) Validate() error {
+	if len(c.Columns) == 0 {
+		return errors.New("columns must be specified")
+	}
+	for _, col := range c.Columns {
+		if col == "" {
+			return errors.New("columns must not contain empty column names")
+		}
+	}
+
+	if !slices.Contains(redactActions, c.Action) {
+		return fmt.Errorf("invalid action '%s': must be one of %v", c.Action, redactActions)
+	}
+
+	switch c.Action {
+	case RedactActionHash:
+		if c.Salt == nil || *c.Salt == "" {
+			return errors.New("salt must be specified for the hash action")
+		}
+	case RedactActionTruncate:
+		if c.Length == nil {
+			return errors.New("length must be specified for the truncate action")
+		}
+	case RedactActionScrub:
+		if len(c.Patterns) == 0 {
+			return errors.New("patterns must be specified for the scrub action")
+		}
+		for _, p := range c.Patterns {
+			if _, err := regexp.Compile(p); err != nil {
+				return fmt.Errorf("invalid pattern '%s': %w", p, err)
+			}
+		}
+	}
+	if c.Length != nil && *c.Length < 0 {
+		return fmt.Errorf("length must not be negative, got %d", *c.Length)
+	}
+	return nil
+}
+
+// GetReplacement returns the scrub replacement string, defaulting to DefaultRedactReplacement
+func (c *RedactConfig) GetReplacement() string {
+	if c.Replacement == nil {
+		return DefaultRedactReplacement
+	}
+	return *c.Replacement
+}
+
+// GeoIPConfig defines the GeoIP/ASN enrichment of IP address columns using local MaxMind (.mmdb) database files
+type GeoIPConfig struct {
+	// the path to a City or Country database
+	Database *string `hcl:"database,optional"`
+	// the path to an ASN database
+	AsnDatabase *string `hcl:"asn_database,optional"`
+	// the IP address columns to enrich - if not specified, tp_source_ip is used
+	Columns []string `hcl:"columns,optional"`
+}
+
+func (c *GeoIPConfig) Validate() error {
+	if c.Database == nil && c.AsnDatabase == nil {
+		return errors.New("at least one of database or asn_database must be specified")
+	}
+	for _, col := range c.Columns {
+		if col == "" {
+			return errors.New("columns must not contain empty column names")
+		}
+	}
+	return nil
+}
+
+// GetColumns returns the IP address columns to enrich, defaulting to tp_source_ip
+func (c *GeoIPConfig) GetColumns() []string {
+	if len(c.Columns) == 0 {
+		return []string{"tp_source_ip"}
+	}
+	return c.Columns
+}
+
+// LookupConfig defines the enrichment of rows from a local CSV or JSON lookup file
+//
+// A CSV file must have a header row. A JSON file must contain either an array of objects,
+// or an object whose keys are the lookup keys and whose values are objects.
+type LookupConfig struct {
+	// the path to the lookup file - the format is determined by the extension (.csv or .json)
+	File string `hcl:"file"`
+	// the row column to join on
+	Column string `hcl:"column"`
+	// the lookup file field containing the key to join on
+	// this is required for CSV files and JSON arrays, and ignored for JSON objects (which are keyed by the object keys)
+	Key *string `hcl:"key,optional"`
+	// map of row column name to lookup file field, defining the columns to add to the row
+	Columns map[string]string `hcl:"columns,optional"`
+	// lookup file fields whose values are added to tp_tags
+	Tags []string `hcl:"tags,optional"`
+	// lookup file fields whose values are added to tp_akas
+	Akas []string `hcl:"akas,optional"`
+}
+
+func (c *LookupConfig) Validate() error {
+	if c.File == "" {
+		return errors.New("file must be specified")
+	}
+	if c.Column == "" {
+		return errors.New("column must be specified")
+	}
+	if len(c.Columns) == 0 && len(c.Tags) == 0 && len(c.Akas) == 0 {
+		return errors.New("at least one of columns, tags or akas must be specified")
+	}
+	for col := range c.Columns {
+		if strings.HasPrefix(col, "tp_") {
+			return fmt.Errorf("cannot add column '%s': tp_ columns are reserved - use tags or akas to populate tp_tags or tp_akas", col)
+		}
+	}
+	switch strings.ToLower(filepath.Ext(c.File)) {
+	case ".csv":
+		if c.Key == nil {
+			return errors.New("key must be specified for CSV lookup files")
+		}
+	case ".json":
+	default:
+		return fmt.Errorf("unsupported lookup file '%s': must be a .csv or .json file", c.File)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package table
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+)
+
+// mandatoryColumns are the common columns which are required for every row and so may not be redacted
+var mandatoryColumns = []string{
+	"tp_id",
+	"tp_source_type",
+	"tp_ingest_timestamp",
+	"tp_timestamp",
+	"tp_table",
+	"tp_partition",
+	"tp_index",
+	"tp_date",
+}
+
+// redactRule is a compiled RedactConfig
+type redactRule struct {
+	config   *RedactConfig
+	patterns []*regexp.Regexp
+}
+
+// redactor is a rowTransform which applies the configured redaction rules to a row
+type redactor struct {
+	rules []*redactRule
+}
+
+func newRedactor(configs []*RedactConfig) (*redactor, error) {
+	r := &redactor{}
+	for _, c := range configs {
+		for _, col := range c.Columns {
+			for _, m := range mandatoryColumns {
+				if col == m {
+					return nil, fmt.Errorf("column '%s' is mandatory and cannot be redacted", col)
+				}
+			}
+		}
+
+		rule := &redactRule{config: c}
+		for _, p := range c.Patterns {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern '%s': %w", p, err)
+			}
+			rule.patterns = append(rule.patterns, re)
+		}
+		r.rules = append(r.rules, rule)
+	}
+	return r, nil
+}
+
+// transform implements rowTransform
+func (r *redactor) transform(row map[string]any) error {
+	for _, rule := range r.rules {
+		for _, col := range rule.config.Columns {
+			value, ok := row[col]
+			if !ok || value == nil {
+				continue
+			}
+			if rule.config.Action == RedactActionDrop {
+				delete(row, col)
+				continue
+			}
+			row[col] = rule.redactValue(value)
+		}
+	}
+	return nil
+}
+
+// describe implements rowTransform
+func (r *redactor) describe() string {
+	var rules []string
+	for _, rule := range r.rules {
+		for _, col := range rule.config.Columns {
+			rules = append(rules, fmt.Sprintf("%s (%s)", col, rule.config.Action))
+		}
+	}
+	return fmt.Sprintf("Redaction applied to columns: %s.", strings.Join(rules, ", "))
+}
+
+// schemaColumns implements rowTransform
+func (r *redactor) schemaColumns() []*schema.ColumnSchema {
+	return nil
+}
+
+// droppedColumns implements rowTransform
+func (r *redactor) droppedColumns() []string {
+	var res []string
+	for _, rule := range r.rules {
+		if rule.config.Action == RedactActionDrop {
+			res = append(res, rule.config.Columns...)
+		}
+	}
+	return res
+}
+
+// stringColumns implements rowTransform
+// all actions except drop convert the column values (or list elements) to strings
+func (r *redactor) stringColumns() []string {
+	var res []string
+	for _, rule := range r.rules {
+		if rule.config.Action != RedactActionDrop {
+			res = append(res, rule.config.Columns...)
+		}
+	}
+	return res
+}
+
+// redactValue redacts a column value - lists are redacted element by element
+func (r *redactRule) redactValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return r.redactString(v)
+	case []any:
+		res := make([]any, len(v))
+		for i, e := range v {
+			if e == nil {
+				continue
+			}
+			res[i] = r.redactValue(e)
+		}
+		return res
+	case []string:
+		res := make([]string, len(v))
+		for i, e := range v {
+			res[i] = r.redactString(e)
+		}
+		return res
+	default:
+		// non string scalars are redacted using their string representation
+		return r.redactString(fmt.Sprint(v))
+	}
+}
+
+func (r *redactRule) redactString(value string) string {
+	switch r.config.Action {
+	case RedactActionHash:
+		h := sha256.Sum256([]byte(*r.config.Salt + value))
+		return hex.EncodeToString(h[:])
+	case RedactActionMask:
+		runes := []rune(value)
+		keep := 0
+		if r.config.Length != nil {
+			keep = min(*r.config.Length, len(runes))
+		}
+		masked := len(runes) - keep
+		return strings.Repeat("*", masked) + string(runes[masked:])
+	case RedactActionTruncate:
+		runes := []rune(value)
+		if len(runes) <= *r.config.Length {
+			return value
+		}
+		return string(runes[:*r.config.Length])
+	case RedactActionScrub:
+		for _, re := range r.patterns {
+			value = re.ReplaceAllString(value, r.config.GetReplacement())
+		}
+		return value
+	}
+	return value
+}
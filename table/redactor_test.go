@@ -0,0 +1,175 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbot/pipe-fittings/v2/utils"
+	"github.com/turbot/tailpipe-plugin-sdk/schema"
+	"github.com/turbot/tailpipe-plugin-sdk/types"
+)
+
+func TestRedactor_Transform(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs []*RedactConfig
+		row     map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name: "hash",
+			configs: []*RedactConfig{
+				{Columns: []string{"email"}, Action: RedactActionHash, Salt: utils.ToPointer("salt")},
+			},
+			row: map[string]any{"email": "a@b.com", "other": "x"},
+			// sha256("salta@b.com")
+			want: map[string]any{"email": "d3bdaa92b6373f6067a450fb11488f88965636df6452f34eff6ffaf7803b1db0", "other": "x"},
+		},
+		{
+			name: "mask keeping trailing characters",
+			configs: []*RedactConfig{
+				{Columns: []string{"token"}, Action: RedactActionMask, Length: utils.ToPointer(4)},
+			},
+			row:  map[string]any{"token": "abcdef123456"},
+			want: map[string]any{"token": "********3456"},
+		},
+		{
+			name: "mask all",
+			configs: []*RedactConfig{
+				{Columns: []string{"token"}, Action: RedactActionMask},
+			},
+			row:  map[string]any{"token": "abc"},
+			want: map[string]any{"token": "***"},
+		},
+		{
+			name: "truncate",
+			configs: []*RedactConfig{
+				{Columns: []string{"ip", "short"}, Action: RedactActionTruncate, Length: utils.ToPointer(3)},
+			},
+			row:  map[string]any{"ip": "10.1.2.3", "short": "ab"},
+			want: map[string]any{"ip": "10.", "short": "ab"},
+		},
+		{
+			name: "drop",
+			configs: []*RedactConfig{
+				{Columns: []string{"secret", "missing"}, Action: RedactActionDrop},
+			},
+			row:  map[string]any{"secret": "s", "other": "x"},
+			want: map[string]any{"other": "x"},
+		},
+		{
+			name: "scrub",
+			configs: []*RedactConfig{
+				{Columns: []string{"message"}, Action: RedactActionScrub, Patterns: []string{`[\w.]+@[\w.]+`, `\d+\.\d+\.\d+\.\d+`}},
+			},
+			row:  map[string]any{"message": "login by a@b.com from 10.1.2.3"},
+			want: map[string]any{"message": "login by [REDACTED] from [REDACTED]"},
+		},
+		{
+			name: "list values are redacted element by element",
+			configs: []*RedactConfig{
+				{Columns: []string{"tp_emails"}, Action: RedactActionMask},
+			},
+			row:  map[string]any{"tp_emails": []any{"ab", "cde"}},
+			want: map[string]any{"tp_emails": []any{"**", "***"}},
+		},
+		{
+			name: "nil values are left unchanged",
+			configs: []*RedactConfig{
+				{Columns: []string{"email"}, Action: RedactActionMask},
+			},
+			row:  map[string]any{"email": nil},
+			want: map[string]any{"email": nil},
+		},
+		{
+			name: "mandatory columns cannot be redacted",
+			configs: []*RedactConfig{
+				{Columns: []string{"tp_timestamp"}, Action: RedactActionDrop},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newRedactor(tt.configs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, r.transform(tt.row))
+			assert.Equal(t, tt.want, tt.row)
+		})
+	}
+}
+
+type redactTestRow struct {
+	schema.CommonFields
+
+	Email string `json:"email"`
+	Other string `json:"other"`
+}
+
+func TestCollectorImpl_GetSchema_redactDrop(t *testing.T) {
+	r, err := newRedactor([]*RedactConfig{
+		{Columns: []string{"email"}, Action: RedactActionDrop},
+		{Columns: []string{"other"}, Action: RedactActionMask},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	c := &CollectorImpl[*redactTestRow]{rowTransforms: []rowTransform{r}}
+
+	s, err := c.GetSchema()
+	if !assert.NoError(t, err) {
+		return
+	}
+	columns := s.AsMap()
+	// dropped columns are removed from the schema, redacted columns are retained
+	assert.NotContains(t, columns, "email")
+	assert.Contains(t, columns, "other")
+	assert.Contains(t, columns, "tp_id")
+}
+
+// columns redacted by actions other than drop are given a string type, as the schema may not have been validated
+// (e.g. a dynamic schema which automaps source fields)
+func TestCollectorImpl_GetSchema_redactString(t *testing.T) {
+	r, err := newRedactor([]*RedactConfig{
+		{Columns: []string{"user_id", "ports"}, Action: RedactActionHash, Salt: utils.ToPointer("salt")},
+		{Columns: []string{"status"}, Action: RedactActionDrop},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	customTable := &types.Table{
+		Name: "custom",
+		Schema: &schema.RowSchema{
+			AutoMapSourceFields: true,
+			Columns: []*schema.ColumnSchema{
+				{ColumnName: "user_id", Type: "BIGINT"},
+				{ColumnName: "ports", Type: "INTEGER[]"},
+				{ColumnName: "status", Type: "INTEGER"},
+				{ColumnName: "count", Type: "BIGINT"},
+			},
+		},
+	}
+	c := &CollectorImpl[*DynamicRow]{
+		req:           &types.CollectRequest{CustomTable: customTable},
+		rowTransforms: []rowTransform{r},
+	}
+
+	s, err := c.GetSchema()
+	if !assert.NoError(t, err) {
+		return
+	}
+	got := make(map[string]string)
+	for _, col := range s.Columns {
+		if !schema.IsCommonField(col.ColumnName) {
+			got[col.ColumnName] = col.Type
+		}
+	}
+	assert.Equal(t, map[string]string{"user_id": "VARCHAR", "ports": "VARCHAR[]", "count": "BIGINT"}, got)
+	// the custom table schema is unchanged
+	assert.Equal(t, "BIGINT", customTable.Schema.Columns[0].Type)
+}
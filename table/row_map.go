@@ -0,0 +1,34 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// rowAsMap converts a row into a map of column name to value, using the JSON representation of the row
+// (this is the same representation which is written to the JSONL files)
+func rowAsMap(row any) (map[string]any, error) {
+	// fast path for dynamic rows
+	if d, ok := row.(*DynamicRow); ok {
+		res := make(map[string]any, len(d.Columns))
+		for k, v := range d.Columns {
+			res[k] = v
+		}
+		return res, nil
+	}
+
+	jsonBytes, err := json.Marshal(row)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling row: %w", err)
+	}
+
+	var res map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	// preserve number formatting
+	decoder.UseNumber()
+	if err := decoder.Decode(&res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling row: %w", err)
+	}
+	return res, nil
+}
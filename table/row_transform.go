@@ -0,0 +1,19 @@
+package table
+
+import "github.com/turbot/tailpipe-plugin-sdk/schema"
+
+// rowTransform is a stage applied to enriched rows before they are buffered for writing
+// Rows are passed to transforms in their map representation (see rowAsMap) so that transforms may add,
+// modify or remove columns regardless of the row struct type
+type rowTransform interface {
+	// transform applies the stage to the row map in place
+	transform(row map[string]any) error
+	// describe returns a description of the stage, which is added to the table schema description
+	describe() string
+	// schemaColumns returns any columns added to the row by the stage
+	schemaColumns() []*schema.ColumnSchema
+	// droppedColumns returns any columns removed from the row by the stage
+	droppedColumns() []string
+	// stringColumns returns any existing columns whose values are converted to strings by the stage
+	stringColumns() []string
+}
@@ -17,6 +17,16 @@ type ArtifactInfo struct {
 	// enrichment values passed from the source to the collection to include in the enrichment process
 	SourceEnrichment *schema.SourceEnrichment `json:"-"`
 	Timestamp        time.Time                `json:"timestamp"`
+
+	// optional integrity information - if set, the downloaded artifact is verified before it is extracted
+	ExpectedSize     *int64    `json:"expected_size,omitempty"`
+	ExpectedChecksum *Checksum `json:"expected_checksum,omitempty"`
+	// a file containing the expected checksum - this is only used if ExpectedChecksum is not set
+	DigestFile *DigestFile `json:"digest_file,omitempty"`
+
+	// if set, only the artifact data from this offset is collected
+	// (i.e. the data appended since the artifact was last collected)
+	CollectFromOffset int64 `json:"collect_from_offset,omitempty"`
 }
 
 func NewArtifactInfo(path string, sourceEnrichment *schema.SourceEnrichment, granularity time.Duration) (*ArtifactInfo, error) {
@@ -41,6 +51,11 @@ func ArtifactInfoFromProto(info *proto.ArtifactInfo) *ArtifactInfo {
 	}
 }
 
+// HasIntegrityInfo returns whether the artifact has an expected size or checksum to verify the download against
+func (a *ArtifactInfo) HasIntegrityInfo() bool {
+	return a.ExpectedSize != nil || a.ExpectedChecksum != nil || a.DigestFile != nil
+}
+
 func (a *ArtifactInfo) ToProto() *proto.ArtifactInfo {
 	return &proto.ArtifactInfo{
 		OriginalName:     a.Name,
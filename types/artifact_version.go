@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// ArtifactVersion identifies the version of an artifact - this is stored in the collection state when the artifact
+// is collected, and used to detect artifacts which have changed since they were collected
+type ArtifactVersion struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time,omitempty"`
+	ETag    string    `json:"etag,omitempty"`
+	// the time this version was collected - this is set by the collection state
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+}
+
+// Equals returns whether the versions are the same
+// if both versions have an ETag, it is used, otherwise the size and modification time are compared
+func (v *ArtifactVersion) Equals(other *ArtifactVersion) bool {
+	if other == nil {
+		return false
+	}
+	if v.ETag != "" && other.ETag != "" {
+		return v.ETag == other.ETag
+	}
+	return v.Size == other.Size && v.ModTime.Equal(other.ModTime)
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"strings"
+)
+
+// ChecksumAlgorithm is the algorithm used to compute an artifact checksum
+type ChecksumAlgorithm string
+
+const (
+	ChecksumAlgorithmMD5    ChecksumAlgorithm = "md5"
+	ChecksumAlgorithmSHA256 ChecksumAlgorithm = "sha256"
+	ChecksumAlgorithmCRC32C ChecksumAlgorithm = "crc32c"
+)
+
+func (a ChecksumAlgorithm) Validate() error {
+	switch a {
+	case ChecksumAlgorithmMD5, ChecksumAlgorithmSHA256, ChecksumAlgorithmCRC32C:
+		return nil
+	default:
+		return fmt.Errorf("unsupported checksum algorithm '%s' - must be one of md5, sha256, crc32c", string(a))
+	}
+}
+
+// NewHash returns a new hash for the algorithm
+func (a ChecksumAlgorithm) NewHash() (hash.Hash, error) {
+	switch a {
+	case ChecksumAlgorithmMD5:
+		return md5.New(), nil
+	case ChecksumAlgorithmSHA256:
+		return sha256.New(), nil
+	case ChecksumAlgorithmCRC32C:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	default:
+		return nil, a.Validate()
+	}
+}
+
+// size returns the size of the checksum in bytes
+func (a ChecksumAlgorithm) size() int {
+	switch a {
+	case ChecksumAlgorithmMD5:
+		return md5.Size
+	case ChecksumAlgorithmSHA256:
+		return sha256.Size
+	case ChecksumAlgorithmCRC32C:
+		return crc32.Size
+	default:
+		return 0
+	}
+}
+
+// Checksum is the expected checksum of an artifact
+type Checksum struct {
+	Algorithm ChecksumAlgorithm `json:"algorithm"`
+	// the hex encoded (lower case) checksum value
+	Value string `json:"value"`
+}
+
+// NewChecksum creates a Checksum, validating the algorithm and value
+// the value may be either hex or base64 encoded (object stores commonly return base64 encoded checksums)
+func NewChecksum(algorithm ChecksumAlgorithm, value string) (*Checksum, error) {
+	if err := algorithm.Validate(); err != nil {
+		return nil, err
+	}
+	value = strings.TrimSpace(value)
+	size := algorithm.size()
+
+	if b, err := hex.DecodeString(value); err == nil && len(b) == size {
+		return &Checksum{Algorithm: algorithm, Value: hex.EncodeToString(b)}, nil
+	}
+	if b, err := base64.StdEncoding.DecodeString(value); err == nil && len(b) == size {
+		return &Checksum{Algorithm: algorithm, Value: hex.EncodeToString(b)}, nil
+	}
+	return nil, fmt.Errorf("invalid %s checksum '%s'", string(algorithm), value)
+}
+
+func (c *Checksum) String() string {
+	return fmt.Sprintf("%s:%s", string(c.Algorithm), c.Value)
+}
+
+// DigestFile is a reference to a file containing the expected checksum of an artifact
+// (for example 'access.log.gz.sha256', as written by sha256sum)
+type DigestFile struct {
+	Algorithm ChecksumAlgorithm `json:"algorithm"`
+	// the name of the digest file, in the same form as the artifact name (i.e. a path, key or URL)
+	Name string `json:"name"`
+}
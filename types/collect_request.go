@@ -10,13 +10,28 @@ import (
 type Table struct {
 	Name   string
 	Schema *schema.RowSchema
+	// if set, the searchable tp_ fields are populated from the row content
+	SearchableFields *SearchableFields
+}
+
+// SearchableFields defines which columns are used to populate the searchable tp_ fields
+// (tp_ips, tp_domains, tp_emails, tp_akas)
+type SearchableFields struct {
+	// the columns to extract searchable values from - if empty, all string columns are used
+	Columns []string
 }
 
 func TableFromProto(pt *proto.Table) *Table {
-	return &Table{
+	t := &Table{
 		Name:   pt.Name,
 		Schema: schema.RowSchemaFromProto(pt.Schema),
 	}
+	if pt.SearchableFields != nil {
+		t.SearchableFields = &SearchableFields{
+			Columns: pt.SearchableFields.Columns,
+		}
+	}
+	return t
 }
 
 // CollectRequest is an sdk type which is mapped from the proto.CollectRequest
@@ -36,8 +51,13 @@ type CollectRequest struct {
 	SourceFormat *FormatConfigData
 	// the raw hcl of the connection
 	ConnectionData *ConnectionConfigData
+	// the raw hcl of the partition level collection options (may be nil)
+	PartitionData *PartitionConfigData
 	// the collection start time
 	From time.Time
+	// the collection end time - if set, this is a windowed collection (e.g. a backfill of a historic period)
+	// which only collects data up to this time and does not update the collection state
+	To time.Time
 	// the custom table definition, if specified
 	CustomTable *Table
 }
@@ -66,6 +86,10 @@ func CollectRequestFromProto(pr *proto.CollectRequest) (*CollectRequest, error)
 		From:                pr.FromTime.AsTime(),
 	}
 
+	if pr.ToTime != nil {
+		req.To = pr.ToTime.AsTime()
+	}
+
 	if pr.SourceFormat != nil {
 		sourceFormat, err := ConfigDataFromProto[*FormatConfigData](pr.SourceFormat)
 		if err != nil {
@@ -81,6 +105,13 @@ func CollectRequestFromProto(pr *proto.CollectRequest) (*CollectRequest, error)
 		}
 		req.ConnectionData = connectionData
 	}
+	if pr.PartitionData != nil {
+		partitionData, err := ConfigDataFromProto[*PartitionConfigData](pr.PartitionData)
+		if err != nil {
+			return nil, err
+		}
+		req.PartitionData = partitionData
+	}
 	if pr.CustomTable != nil {
 		req.CustomTable = TableFromProto(pr.CustomTable)
 	}
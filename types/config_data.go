@@ -79,6 +79,15 @@ func ConfigDataFromProto[T ConfigData](data *proto.ConfigData) (T, error) {
 		}
 		d := NewConnectionConfigData(data.Hcl, proto.RangeFromProto(data.Range), parts[1])
 		return ConfigData(d).(T), nil
+	case *PartitionConfigData:
+		if len(parts) != 3 {
+			return empty, fmt.Errorf("invalid partition config target %s: expected a name of format partition.<table>.<partition>", data.Target)
+		}
+		if parts[0] != "partition" {
+			return empty, fmt.Errorf("invalid partition config target %s: expected a partition", data.Target)
+		}
+		d := NewPartitionConfigData(data.Hcl, proto.RangeFromProto(data.Range), parts[1], parts[2])
+		return ConfigData(d).(T), nil
 	case *FormatConfigData:
 		d := NewFormatConfigData(data.Hcl, proto.RangeFromProto(data.Range), data.Target)
 		return ConfigData(d).(T), nil
@@ -13,6 +13,13 @@ type DownloadedArtifactInfo struct {
 	LocalName string `json:"local_name"`
 
 	Size int64 `json:"size"`
+
+	// the version of the artifact which was downloaded (if known) - this is set by sources which support detecting
+	// changed artifacts, and is stored in the collection state when the artifact is collected
+	Version *ArtifactVersion `json:"version,omitempty"`
+
+	// the checksums computed when verifying the download, keyed by algorithm (hex encoded)
+	Checksums map[ChecksumAlgorithm]string `json:"checksums,omitempty"`
 }
 
 func NewDownloadedArtifactInfo(artifactInfo *ArtifactInfo, localName string, size int64) *DownloadedArtifactInfo {
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/tailpipe-plugin-sdk/grpc/proto"
+)
+
+type PartitionConfigData struct {
+	*ConfigDataImpl
+	Table     string
+	Partition string
+}
+
+func NewPartitionConfigData(hcl []byte, decRange hcl.Range, table, partition string) *PartitionConfigData {
+	return &PartitionConfigData{
+		ConfigDataImpl: &ConfigDataImpl{
+			Hcl:        hcl,
+			Range:      decRange,
+			Id:         table,
+			ConfigType: "partition",
+		},
+		Table:     table,
+		Partition: partition,
+	}
+}
+
+// AsProto overrides the base implementation to include the partition name in the target
+func (d *PartitionConfigData) AsProto() *proto.ConfigData {
+	res := d.ConfigDataImpl.AsProto()
+	res.Target = fmt.Sprintf("%s.%s.%s", d.ConfigType, d.Table, d.Partition)
+	return res
+}